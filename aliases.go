@@ -0,0 +1,389 @@
+package main
+
+type nameAlias struct {
+	alias string
+	kind  string
+}
+
+// NameAliases-14.0.0.txt より生成
+// kind は correction, control, alternate, figment, abbreviation のいずれか
+var nameAliases = map[rune][]nameAlias{
+	0x0000:  {{"NULL", "control"}, {"NUL", "abbreviation"}},
+	0x0001:  {{"START OF HEADING", "control"}, {"SOH", "abbreviation"}},
+	0x0002:  {{"START OF TEXT", "control"}, {"STX", "abbreviation"}},
+	0x0003:  {{"END OF TEXT", "control"}, {"ETX", "abbreviation"}},
+	0x0004:  {{"END OF TRANSMISSION", "control"}, {"EOT", "abbreviation"}},
+	0x0005:  {{"ENQUIRY", "control"}, {"ENQ", "abbreviation"}},
+	0x0006:  {{"ACKNOWLEDGE", "control"}, {"ACK", "abbreviation"}},
+	0x0007:  {{"ALERT", "control"}, {"BEL", "abbreviation"}},
+	0x0008:  {{"BACKSPACE", "control"}, {"BS", "abbreviation"}},
+	0x0009:  {{"CHARACTER TABULATION", "control"}, {"HORIZONTAL TABULATION", "control"}, {"HT", "abbreviation"}, {"TAB", "abbreviation"}},
+	0x000A:  {{"LINE FEED", "control"}, {"NEW LINE", "control"}, {"END OF LINE", "control"}, {"LF", "abbreviation"}, {"NL", "abbreviation"}, {"EOL", "abbreviation"}},
+	0x000B:  {{"LINE TABULATION", "control"}, {"VERTICAL TABULATION", "control"}, {"VT", "abbreviation"}},
+	0x000C:  {{"FORM FEED", "control"}, {"FF", "abbreviation"}},
+	0x000D:  {{"CARRIAGE RETURN", "control"}, {"CR", "abbreviation"}},
+	0x000E:  {{"SHIFT OUT", "control"}, {"LOCKING-SHIFT ONE", "control"}, {"SO", "abbreviation"}},
+	0x000F:  {{"SHIFT IN", "control"}, {"LOCKING-SHIFT ZERO", "control"}, {"SI", "abbreviation"}},
+	0x0010:  {{"DATA LINK ESCAPE", "control"}, {"DLE", "abbreviation"}},
+	0x0011:  {{"DEVICE CONTROL ONE", "control"}, {"DC1", "abbreviation"}},
+	0x0012:  {{"DEVICE CONTROL TWO", "control"}, {"DC2", "abbreviation"}},
+	0x0013:  {{"DEVICE CONTROL THREE", "control"}, {"DC3", "abbreviation"}},
+	0x0014:  {{"DEVICE CONTROL FOUR", "control"}, {"DC4", "abbreviation"}},
+	0x0015:  {{"NEGATIVE ACKNOWLEDGE", "control"}, {"NAK", "abbreviation"}},
+	0x0016:  {{"SYNCHRONOUS IDLE", "control"}, {"SYN", "abbreviation"}},
+	0x0017:  {{"END OF TRANSMISSION BLOCK", "control"}, {"ETB", "abbreviation"}},
+	0x0018:  {{"CANCEL", "control"}, {"CAN", "abbreviation"}},
+	0x0019:  {{"END OF MEDIUM", "control"}, {"EOM", "abbreviation"}},
+	0x001A:  {{"SUBSTITUTE", "control"}, {"SUB", "abbreviation"}},
+	0x001B:  {{"ESCAPE", "control"}, {"ESC", "abbreviation"}},
+	0x001C:  {{"INFORMATION SEPARATOR FOUR", "control"}, {"FILE SEPARATOR", "control"}, {"FS", "abbreviation"}},
+	0x001D:  {{"INFORMATION SEPARATOR THREE", "control"}, {"GROUP SEPARATOR", "control"}, {"GS", "abbreviation"}},
+	0x001E:  {{"INFORMATION SEPARATOR TWO", "control"}, {"RECORD SEPARATOR", "control"}, {"RS", "abbreviation"}},
+	0x001F:  {{"INFORMATION SEPARATOR ONE", "control"}, {"UNIT SEPARATOR", "control"}, {"US", "abbreviation"}},
+	0x0020:  {{"SP", "abbreviation"}},
+	0x007F:  {{"DELETE", "control"}, {"DEL", "abbreviation"}},
+	0x0080:  {{"PADDING CHARACTER", "figment"}, {"PAD", "abbreviation"}},
+	0x0081:  {{"HIGH OCTET PRESET", "figment"}, {"HOP", "abbreviation"}},
+	0x0082:  {{"BREAK PERMITTED HERE", "control"}, {"BPH", "abbreviation"}},
+	0x0083:  {{"NO BREAK HERE", "control"}, {"NBH", "abbreviation"}},
+	0x0084:  {{"INDEX", "control"}, {"IND", "abbreviation"}},
+	0x0085:  {{"NEXT LINE", "control"}, {"NEL", "abbreviation"}},
+	0x0086:  {{"START OF SELECTED AREA", "control"}, {"SSA", "abbreviation"}},
+	0x0087:  {{"END OF SELECTED AREA", "control"}, {"ESA", "abbreviation"}},
+	0x0088:  {{"CHARACTER TABULATION SET", "control"}, {"HORIZONTAL TABULATION SET", "control"}, {"HTS", "abbreviation"}},
+	0x0089:  {{"CHARACTER TABULATION WITH JUSTIFICATION", "control"}, {"HORIZONTAL TABULATION WITH JUSTIFICATION", "control"}, {"HTJ", "abbreviation"}},
+	0x008A:  {{"LINE TABULATION SET", "control"}, {"VERTICAL TABULATION SET", "control"}, {"VTS", "abbreviation"}},
+	0x008B:  {{"PARTIAL LINE FORWARD", "control"}, {"PARTIAL LINE DOWN", "control"}, {"PLD", "abbreviation"}},
+	0x008C:  {{"PARTIAL LINE BACKWARD", "control"}, {"PARTIAL LINE UP", "control"}, {"PLU", "abbreviation"}},
+	0x008D:  {{"REVERSE LINE FEED", "control"}, {"REVERSE INDEX", "control"}, {"RI", "abbreviation"}},
+	0x008E:  {{"SINGLE SHIFT TWO", "control"}, {"SINGLE-SHIFT-2", "control"}, {"SS2", "abbreviation"}},
+	0x008F:  {{"SINGLE SHIFT THREE", "control"}, {"SINGLE-SHIFT-3", "control"}, {"SS3", "abbreviation"}},
+	0x0090:  {{"DEVICE CONTROL STRING", "control"}, {"DCS", "abbreviation"}},
+	0x0091:  {{"PRIVATE USE ONE", "control"}, {"PRIVATE USE-1", "control"}, {"PU1", "abbreviation"}},
+	0x0092:  {{"PRIVATE USE TWO", "control"}, {"PRIVATE USE-2", "control"}, {"PU2", "abbreviation"}},
+	0x0093:  {{"SET TRANSMIT STATE", "control"}, {"STS", "abbreviation"}},
+	0x0094:  {{"CANCEL CHARACTER", "control"}, {"CCH", "abbreviation"}},
+	0x0095:  {{"MESSAGE WAITING", "control"}, {"MW", "abbreviation"}},
+	0x0096:  {{"START OF GUARDED AREA", "control"}, {"START OF PROTECTED AREA", "control"}, {"SPA", "abbreviation"}},
+	0x0097:  {{"END OF GUARDED AREA", "control"}, {"END OF PROTECTED AREA", "control"}, {"EPA", "abbreviation"}},
+	0x0098:  {{"START OF STRING", "control"}, {"SOS", "abbreviation"}},
+	0x0099:  {{"SINGLE GRAPHIC CHARACTER INTRODUCER", "figment"}, {"SGC", "abbreviation"}},
+	0x009A:  {{"SINGLE CHARACTER INTRODUCER", "control"}, {"SCI", "abbreviation"}},
+	0x009B:  {{"CONTROL SEQUENCE INTRODUCER", "control"}, {"CSI", "abbreviation"}},
+	0x009C:  {{"STRING TERMINATOR", "control"}, {"ST", "abbreviation"}},
+	0x009D:  {{"OPERATING SYSTEM COMMAND", "control"}, {"OSC", "abbreviation"}},
+	0x009E:  {{"PRIVACY MESSAGE", "control"}, {"PM", "abbreviation"}},
+	0x009F:  {{"APPLICATION PROGRAM COMMAND", "control"}, {"APC", "abbreviation"}},
+	0x00A0:  {{"NBSP", "abbreviation"}},
+	0x00AD:  {{"SHY", "abbreviation"}},
+	0x01A2:  {{"LATIN CAPITAL LETTER GHA", "correction"}},
+	0x01A3:  {{"LATIN SMALL LETTER GHA", "correction"}},
+	0x034F:  {{"CGJ", "abbreviation"}},
+	0x061C:  {{"ALM", "abbreviation"}},
+	0x0709:  {{"SYRIAC SUBLINEAR COLON SKEWED LEFT", "correction"}},
+	0x0CDE:  {{"KANNADA LETTER LLLA", "correction"}},
+	0x0E9D:  {{"LAO LETTER FO FON", "correction"}},
+	0x0E9F:  {{"LAO LETTER FO FAY", "correction"}},
+	0x0EA3:  {{"LAO LETTER RO", "correction"}},
+	0x0EA5:  {{"LAO LETTER LO", "correction"}},
+	0x0FD0:  {{"TIBETAN MARK BKA- SHOG GI MGO RGYAN", "correction"}},
+	0x11EC:  {{"HANGUL JONGSEONG YESIEUNG-KIYEOK", "correction"}},
+	0x11ED:  {{"HANGUL JONGSEONG YESIEUNG-SSANGKIYEOK", "correction"}},
+	0x11EE:  {{"HANGUL JONGSEONG SSANGYESIEUNG", "correction"}},
+	0x11EF:  {{"HANGUL JONGSEONG YESIEUNG-KHIEUKH", "correction"}},
+	0x180B:  {{"FVS1", "abbreviation"}},
+	0x180C:  {{"FVS2", "abbreviation"}},
+	0x180D:  {{"FVS3", "abbreviation"}},
+	0x180E:  {{"MVS", "abbreviation"}},
+	0x180F:  {{"FVS4", "abbreviation"}},
+	0x200B:  {{"ZWSP", "abbreviation"}},
+	0x200C:  {{"ZWNJ", "abbreviation"}},
+	0x200D:  {{"ZWJ", "abbreviation"}},
+	0x200E:  {{"LRM", "abbreviation"}},
+	0x200F:  {{"RLM", "abbreviation"}},
+	0x202A:  {{"LRE", "abbreviation"}},
+	0x202B:  {{"RLE", "abbreviation"}},
+	0x202C:  {{"PDF", "abbreviation"}},
+	0x202D:  {{"LRO", "abbreviation"}},
+	0x202E:  {{"RLO", "abbreviation"}},
+	0x202F:  {{"NNBSP", "abbreviation"}},
+	0x205F:  {{"MMSP", "abbreviation"}},
+	0x2060:  {{"WJ", "abbreviation"}},
+	0x2066:  {{"LRI", "abbreviation"}},
+	0x2067:  {{"RLI", "abbreviation"}},
+	0x2068:  {{"FSI", "abbreviation"}},
+	0x2069:  {{"PDI", "abbreviation"}},
+	0x2118:  {{"WEIERSTRASS ELLIPTIC FUNCTION", "correction"}},
+	0x2448:  {{"MICR ON US SYMBOL", "correction"}},
+	0x2449:  {{"MICR DASH SYMBOL", "correction"}},
+	0x2B7A:  {{"LEFTWARDS TRIANGLE-HEADED ARROW WITH DOUBLE VERTICAL STROKE", "correction"}},
+	0x2B7C:  {{"RIGHTWARDS TRIANGLE-HEADED ARROW WITH DOUBLE VERTICAL STROKE", "correction"}},
+	0xA015:  {{"YI SYLLABLE ITERATION MARK", "correction"}},
+	0xAA6E:  {{"MYANMAR LETTER KHAMTI LLA", "correction"}},
+	0xFE00:  {{"VS1", "abbreviation"}},
+	0xFE01:  {{"VS2", "abbreviation"}},
+	0xFE02:  {{"VS3", "abbreviation"}},
+	0xFE03:  {{"VS4", "abbreviation"}},
+	0xFE04:  {{"VS5", "abbreviation"}},
+	0xFE05:  {{"VS6", "abbreviation"}},
+	0xFE06:  {{"VS7", "abbreviation"}},
+	0xFE07:  {{"VS8", "abbreviation"}},
+	0xFE08:  {{"VS9", "abbreviation"}},
+	0xFE09:  {{"VS10", "abbreviation"}},
+	0xFE0A:  {{"VS11", "abbreviation"}},
+	0xFE0B:  {{"VS12", "abbreviation"}},
+	0xFE0C:  {{"VS13", "abbreviation"}},
+	0xFE0D:  {{"VS14", "abbreviation"}},
+	0xFE0E:  {{"VS15", "abbreviation"}},
+	0xFE0F:  {{"VS16", "abbreviation"}},
+	0xFE18:  {{"PRESENTATION FORM FOR VERTICAL RIGHT WHITE LENTICULAR BRACKET", "correction"}},
+	0xFEFF:  {{"BYTE ORDER MARK", "alternate"}, {"BOM", "abbreviation"}, {"ZWNBSP", "abbreviation"}},
+	0x122D4: {{"CUNEIFORM SIGN NU11 TENU", "correction"}},
+	0x122D5: {{"CUNEIFORM SIGN NU11 OVER NU11 BUR OVER BUR", "correction"}},
+	0x16E56: {{"MEDEFAIDRIN CAPITAL LETTER H", "correction"}},
+	0x16E57: {{"MEDEFAIDRIN CAPITAL LETTER NG", "correction"}},
+	0x16E76: {{"MEDEFAIDRIN SMALL LETTER H", "correction"}},
+	0x16E77: {{"MEDEFAIDRIN SMALL LETTER NG", "correction"}},
+	0x1B001: {{"HENTAIGANA LETTER E-1", "correction"}},
+	0x1D0C5: {{"BYZANTINE MUSICAL SYMBOL FTHORA SKLIRON CHROMA VASIS", "correction"}},
+	0xE0100: {{"VS17", "abbreviation"}},
+	0xE0101: {{"VS18", "abbreviation"}},
+	0xE0102: {{"VS19", "abbreviation"}},
+	0xE0103: {{"VS20", "abbreviation"}},
+	0xE0104: {{"VS21", "abbreviation"}},
+	0xE0105: {{"VS22", "abbreviation"}},
+	0xE0106: {{"VS23", "abbreviation"}},
+	0xE0107: {{"VS24", "abbreviation"}},
+	0xE0108: {{"VS25", "abbreviation"}},
+	0xE0109: {{"VS26", "abbreviation"}},
+	0xE010A: {{"VS27", "abbreviation"}},
+	0xE010B: {{"VS28", "abbreviation"}},
+	0xE010C: {{"VS29", "abbreviation"}},
+	0xE010D: {{"VS30", "abbreviation"}},
+	0xE010E: {{"VS31", "abbreviation"}},
+	0xE010F: {{"VS32", "abbreviation"}},
+	0xE0110: {{"VS33", "abbreviation"}},
+	0xE0111: {{"VS34", "abbreviation"}},
+	0xE0112: {{"VS35", "abbreviation"}},
+	0xE0113: {{"VS36", "abbreviation"}},
+	0xE0114: {{"VS37", "abbreviation"}},
+	0xE0115: {{"VS38", "abbreviation"}},
+	0xE0116: {{"VS39", "abbreviation"}},
+	0xE0117: {{"VS40", "abbreviation"}},
+	0xE0118: {{"VS41", "abbreviation"}},
+	0xE0119: {{"VS42", "abbreviation"}},
+	0xE011A: {{"VS43", "abbreviation"}},
+	0xE011B: {{"VS44", "abbreviation"}},
+	0xE011C: {{"VS45", "abbreviation"}},
+	0xE011D: {{"VS46", "abbreviation"}},
+	0xE011E: {{"VS47", "abbreviation"}},
+	0xE011F: {{"VS48", "abbreviation"}},
+	0xE0120: {{"VS49", "abbreviation"}},
+	0xE0121: {{"VS50", "abbreviation"}},
+	0xE0122: {{"VS51", "abbreviation"}},
+	0xE0123: {{"VS52", "abbreviation"}},
+	0xE0124: {{"VS53", "abbreviation"}},
+	0xE0125: {{"VS54", "abbreviation"}},
+	0xE0126: {{"VS55", "abbreviation"}},
+	0xE0127: {{"VS56", "abbreviation"}},
+	0xE0128: {{"VS57", "abbreviation"}},
+	0xE0129: {{"VS58", "abbreviation"}},
+	0xE012A: {{"VS59", "abbreviation"}},
+	0xE012B: {{"VS60", "abbreviation"}},
+	0xE012C: {{"VS61", "abbreviation"}},
+	0xE012D: {{"VS62", "abbreviation"}},
+	0xE012E: {{"VS63", "abbreviation"}},
+	0xE012F: {{"VS64", "abbreviation"}},
+	0xE0130: {{"VS65", "abbreviation"}},
+	0xE0131: {{"VS66", "abbreviation"}},
+	0xE0132: {{"VS67", "abbreviation"}},
+	0xE0133: {{"VS68", "abbreviation"}},
+	0xE0134: {{"VS69", "abbreviation"}},
+	0xE0135: {{"VS70", "abbreviation"}},
+	0xE0136: {{"VS71", "abbreviation"}},
+	0xE0137: {{"VS72", "abbreviation"}},
+	0xE0138: {{"VS73", "abbreviation"}},
+	0xE0139: {{"VS74", "abbreviation"}},
+	0xE013A: {{"VS75", "abbreviation"}},
+	0xE013B: {{"VS76", "abbreviation"}},
+	0xE013C: {{"VS77", "abbreviation"}},
+	0xE013D: {{"VS78", "abbreviation"}},
+	0xE013E: {{"VS79", "abbreviation"}},
+	0xE013F: {{"VS80", "abbreviation"}},
+	0xE0140: {{"VS81", "abbreviation"}},
+	0xE0141: {{"VS82", "abbreviation"}},
+	0xE0142: {{"VS83", "abbreviation"}},
+	0xE0143: {{"VS84", "abbreviation"}},
+	0xE0144: {{"VS85", "abbreviation"}},
+	0xE0145: {{"VS86", "abbreviation"}},
+	0xE0146: {{"VS87", "abbreviation"}},
+	0xE0147: {{"VS88", "abbreviation"}},
+	0xE0148: {{"VS89", "abbreviation"}},
+	0xE0149: {{"VS90", "abbreviation"}},
+	0xE014A: {{"VS91", "abbreviation"}},
+	0xE014B: {{"VS92", "abbreviation"}},
+	0xE014C: {{"VS93", "abbreviation"}},
+	0xE014D: {{"VS94", "abbreviation"}},
+	0xE014E: {{"VS95", "abbreviation"}},
+	0xE014F: {{"VS96", "abbreviation"}},
+	0xE0150: {{"VS97", "abbreviation"}},
+	0xE0151: {{"VS98", "abbreviation"}},
+	0xE0152: {{"VS99", "abbreviation"}},
+	0xE0153: {{"VS100", "abbreviation"}},
+	0xE0154: {{"VS101", "abbreviation"}},
+	0xE0155: {{"VS102", "abbreviation"}},
+	0xE0156: {{"VS103", "abbreviation"}},
+	0xE0157: {{"VS104", "abbreviation"}},
+	0xE0158: {{"VS105", "abbreviation"}},
+	0xE0159: {{"VS106", "abbreviation"}},
+	0xE015A: {{"VS107", "abbreviation"}},
+	0xE015B: {{"VS108", "abbreviation"}},
+	0xE015C: {{"VS109", "abbreviation"}},
+	0xE015D: {{"VS110", "abbreviation"}},
+	0xE015E: {{"VS111", "abbreviation"}},
+	0xE015F: {{"VS112", "abbreviation"}},
+	0xE0160: {{"VS113", "abbreviation"}},
+	0xE0161: {{"VS114", "abbreviation"}},
+	0xE0162: {{"VS115", "abbreviation"}},
+	0xE0163: {{"VS116", "abbreviation"}},
+	0xE0164: {{"VS117", "abbreviation"}},
+	0xE0165: {{"VS118", "abbreviation"}},
+	0xE0166: {{"VS119", "abbreviation"}},
+	0xE0167: {{"VS120", "abbreviation"}},
+	0xE0168: {{"VS121", "abbreviation"}},
+	0xE0169: {{"VS122", "abbreviation"}},
+	0xE016A: {{"VS123", "abbreviation"}},
+	0xE016B: {{"VS124", "abbreviation"}},
+	0xE016C: {{"VS125", "abbreviation"}},
+	0xE016D: {{"VS126", "abbreviation"}},
+	0xE016E: {{"VS127", "abbreviation"}},
+	0xE016F: {{"VS128", "abbreviation"}},
+	0xE0170: {{"VS129", "abbreviation"}},
+	0xE0171: {{"VS130", "abbreviation"}},
+	0xE0172: {{"VS131", "abbreviation"}},
+	0xE0173: {{"VS132", "abbreviation"}},
+	0xE0174: {{"VS133", "abbreviation"}},
+	0xE0175: {{"VS134", "abbreviation"}},
+	0xE0176: {{"VS135", "abbreviation"}},
+	0xE0177: {{"VS136", "abbreviation"}},
+	0xE0178: {{"VS137", "abbreviation"}},
+	0xE0179: {{"VS138", "abbreviation"}},
+	0xE017A: {{"VS139", "abbreviation"}},
+	0xE017B: {{"VS140", "abbreviation"}},
+	0xE017C: {{"VS141", "abbreviation"}},
+	0xE017D: {{"VS142", "abbreviation"}},
+	0xE017E: {{"VS143", "abbreviation"}},
+	0xE017F: {{"VS144", "abbreviation"}},
+	0xE0180: {{"VS145", "abbreviation"}},
+	0xE0181: {{"VS146", "abbreviation"}},
+	0xE0182: {{"VS147", "abbreviation"}},
+	0xE0183: {{"VS148", "abbreviation"}},
+	0xE0184: {{"VS149", "abbreviation"}},
+	0xE0185: {{"VS150", "abbreviation"}},
+	0xE0186: {{"VS151", "abbreviation"}},
+	0xE0187: {{"VS152", "abbreviation"}},
+	0xE0188: {{"VS153", "abbreviation"}},
+	0xE0189: {{"VS154", "abbreviation"}},
+	0xE018A: {{"VS155", "abbreviation"}},
+	0xE018B: {{"VS156", "abbreviation"}},
+	0xE018C: {{"VS157", "abbreviation"}},
+	0xE018D: {{"VS158", "abbreviation"}},
+	0xE018E: {{"VS159", "abbreviation"}},
+	0xE018F: {{"VS160", "abbreviation"}},
+	0xE0190: {{"VS161", "abbreviation"}},
+	0xE0191: {{"VS162", "abbreviation"}},
+	0xE0192: {{"VS163", "abbreviation"}},
+	0xE0193: {{"VS164", "abbreviation"}},
+	0xE0194: {{"VS165", "abbreviation"}},
+	0xE0195: {{"VS166", "abbreviation"}},
+	0xE0196: {{"VS167", "abbreviation"}},
+	0xE0197: {{"VS168", "abbreviation"}},
+	0xE0198: {{"VS169", "abbreviation"}},
+	0xE0199: {{"VS170", "abbreviation"}},
+	0xE019A: {{"VS171", "abbreviation"}},
+	0xE019B: {{"VS172", "abbreviation"}},
+	0xE019C: {{"VS173", "abbreviation"}},
+	0xE019D: {{"VS174", "abbreviation"}},
+	0xE019E: {{"VS175", "abbreviation"}},
+	0xE019F: {{"VS176", "abbreviation"}},
+	0xE01A0: {{"VS177", "abbreviation"}},
+	0xE01A1: {{"VS178", "abbreviation"}},
+	0xE01A2: {{"VS179", "abbreviation"}},
+	0xE01A3: {{"VS180", "abbreviation"}},
+	0xE01A4: {{"VS181", "abbreviation"}},
+	0xE01A5: {{"VS182", "abbreviation"}},
+	0xE01A6: {{"VS183", "abbreviation"}},
+	0xE01A7: {{"VS184", "abbreviation"}},
+	0xE01A8: {{"VS185", "abbreviation"}},
+	0xE01A9: {{"VS186", "abbreviation"}},
+	0xE01AA: {{"VS187", "abbreviation"}},
+	0xE01AB: {{"VS188", "abbreviation"}},
+	0xE01AC: {{"VS189", "abbreviation"}},
+	0xE01AD: {{"VS190", "abbreviation"}},
+	0xE01AE: {{"VS191", "abbreviation"}},
+	0xE01AF: {{"VS192", "abbreviation"}},
+	0xE01B0: {{"VS193", "abbreviation"}},
+	0xE01B1: {{"VS194", "abbreviation"}},
+	0xE01B2: {{"VS195", "abbreviation"}},
+	0xE01B3: {{"VS196", "abbreviation"}},
+	0xE01B4: {{"VS197", "abbreviation"}},
+	0xE01B5: {{"VS198", "abbreviation"}},
+	0xE01B6: {{"VS199", "abbreviation"}},
+	0xE01B7: {{"VS200", "abbreviation"}},
+	0xE01B8: {{"VS201", "abbreviation"}},
+	0xE01B9: {{"VS202", "abbreviation"}},
+	0xE01BA: {{"VS203", "abbreviation"}},
+	0xE01BB: {{"VS204", "abbreviation"}},
+	0xE01BC: {{"VS205", "abbreviation"}},
+	0xE01BD: {{"VS206", "abbreviation"}},
+	0xE01BE: {{"VS207", "abbreviation"}},
+	0xE01BF: {{"VS208", "abbreviation"}},
+	0xE01C0: {{"VS209", "abbreviation"}},
+	0xE01C1: {{"VS210", "abbreviation"}},
+	0xE01C2: {{"VS211", "abbreviation"}},
+	0xE01C3: {{"VS212", "abbreviation"}},
+	0xE01C4: {{"VS213", "abbreviation"}},
+	0xE01C5: {{"VS214", "abbreviation"}},
+	0xE01C6: {{"VS215", "abbreviation"}},
+	0xE01C7: {{"VS216", "abbreviation"}},
+	0xE01C8: {{"VS217", "abbreviation"}},
+	0xE01C9: {{"VS218", "abbreviation"}},
+	0xE01CA: {{"VS219", "abbreviation"}},
+	0xE01CB: {{"VS220", "abbreviation"}},
+	0xE01CC: {{"VS221", "abbreviation"}},
+	0xE01CD: {{"VS222", "abbreviation"}},
+	0xE01CE: {{"VS223", "abbreviation"}},
+	0xE01CF: {{"VS224", "abbreviation"}},
+	0xE01D0: {{"VS225", "abbreviation"}},
+	0xE01D1: {{"VS226", "abbreviation"}},
+	0xE01D2: {{"VS227", "abbreviation"}},
+	0xE01D3: {{"VS228", "abbreviation"}},
+	0xE01D4: {{"VS229", "abbreviation"}},
+	0xE01D5: {{"VS230", "abbreviation"}},
+	0xE01D6: {{"VS231", "abbreviation"}},
+	0xE01D7: {{"VS232", "abbreviation"}},
+	0xE01D8: {{"VS233", "abbreviation"}},
+	0xE01D9: {{"VS234", "abbreviation"}},
+	0xE01DA: {{"VS235", "abbreviation"}},
+	0xE01DB: {{"VS236", "abbreviation"}},
+	0xE01DC: {{"VS237", "abbreviation"}},
+	0xE01DD: {{"VS238", "abbreviation"}},
+	0xE01DE: {{"VS239", "abbreviation"}},
+	0xE01DF: {{"VS240", "abbreviation"}},
+	0xE01E0: {{"VS241", "abbreviation"}},
+	0xE01E1: {{"VS242", "abbreviation"}},
+	0xE01E2: {{"VS243", "abbreviation"}},
+	0xE01E3: {{"VS244", "abbreviation"}},
+	0xE01E4: {{"VS245", "abbreviation"}},
+	0xE01E5: {{"VS246", "abbreviation"}},
+	0xE01E6: {{"VS247", "abbreviation"}},
+	0xE01E7: {{"VS248", "abbreviation"}},
+	0xE01E8: {{"VS249", "abbreviation"}},
+	0xE01E9: {{"VS250", "abbreviation"}},
+	0xE01EA: {{"VS251", "abbreviation"}},
+	0xE01EB: {{"VS252", "abbreviation"}},
+	0xE01EC: {{"VS253", "abbreviation"}},
+	0xE01ED: {{"VS254", "abbreviation"}},
+	0xE01EE: {{"VS255", "abbreviation"}},
+	0xE01EF: {{"VS256", "abbreviation"}},
+}
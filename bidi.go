@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/text/unicode/bidi"
+)
+
+var bidiClassNames = map[bidi.Class]string{
+	bidi.L:   "L",
+	bidi.R:   "R",
+	bidi.EN:  "EN",
+	bidi.ES:  "ES",
+	bidi.ET:  "ET",
+	bidi.AN:  "AN",
+	bidi.CS:  "CS",
+	bidi.B:   "B",
+	bidi.S:   "S",
+	bidi.WS:  "WS",
+	bidi.ON:  "ON",
+	bidi.BN:  "BN",
+	bidi.NSM: "NSM",
+	bidi.AL:  "AL",
+	bidi.LRO: "LRO",
+	bidi.RLO: "RLO",
+	bidi.LRE: "LRE",
+	bidi.RLE: "RLE",
+	bidi.PDF: "PDF",
+	bidi.LRI: "LRI",
+	bidi.RLI: "RLI",
+	bidi.FSI: "FSI",
+	bidi.PDI: "PDI",
+}
+
+// bidiClass は r の Bidi_Class の略称を返す
+func bidiClass(r rune) string {
+	p, _ := bidi.LookupRune(r)
+	return bidiClassNames[p.Class()]
+}
+
+// bidiControl は開いたままの埋め込み、上書き、分離の制御文字とその位置を表す
+type bidiControl struct {
+	rune    rune
+	isolate bool
+	line    int64
+	column  int64
+}
+
+func (c bidiControl) String() string {
+	return fmt.Sprintf("%U %s", c.rune, bidiClass(c.rune))
+}
+
+// bidiTracker は双方向制御文字(U+202A-U+202E, U+2066-U+2069)の入れ子を行ごとに追跡し、
+// 閉じられていないもの、入れ子になったもの、対応するものがないものを報告する
+type bidiTracker struct {
+	stack []bidiControl
+}
+
+// add は token による状態の変化を反映し、見つかった問題を path:line:column: message の形式で返す
+func (b *bidiTracker) add(path string, token *Token) []string {
+	if !token.IsCharacter() {
+		return nil
+	}
+
+	problems := []string{}
+	report := func(line int64, column int64, msg string) {
+		problems = append(problems, fmt.Sprintf("%s:%d:%d: %s", path, line, column, msg))
+	}
+
+	control := bidiControl{rune: token.Rune, line: token.Line, column: token.Column}
+	switch token.Rune {
+	case 0x202a, 0x202b, 0x202d, 0x202e, 0x2066, 0x2067, 0x2068:
+		control.isolate = token.Rune >= 0x2066
+		if len(b.stack) > 0 {
+			report(token.Line, token.Column, fmt.Sprintf("nested bidi control %s inside %s", control, b.stack[len(b.stack)-1]))
+		}
+		b.stack = append(b.stack, control)
+	case 0x202c:
+		// PDF は直近の分離より内側にある埋め込みと上書きだけを閉じる
+		if len(b.stack) == 0 || b.stack[len(b.stack)-1].isolate {
+			report(token.Line, token.Column, fmt.Sprintf("unmatched bidi control %s", control))
+		} else {
+			b.stack = b.stack[:len(b.stack)-1]
+		}
+	case 0x2069:
+		// PDI は直近の分離と、その内側で開いたままの埋め込みと上書きを閉じる
+		i := len(b.stack) - 1
+		for i >= 0 && !b.stack[i].isolate {
+			i--
+		}
+		if i < 0 {
+			report(token.Line, token.Column, fmt.Sprintf("unmatched bidi control %s", control))
+		} else {
+			b.stack = b.stack[:i]
+		}
+	default:
+		if isLineTerminator(token.Rune) {
+			problems = append(problems, b.flush(path)...)
+		}
+	}
+	return problems
+}
+
+// flush は行末またはファイルの終わりで閉じられていない制御文字を報告し、状態を初期化する
+func (b *bidiTracker) flush(path string) []string {
+	problems := []string{}
+	for _, c := range b.stack {
+		problems = append(problems, fmt.Sprintf("%s:%d:%d: unterminated bidi control %s", path, c.line, c.column, c))
+	}
+	b.stack = nil
+	return problems
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBidiClass(t *testing.T) {
+
+	cases := []struct {
+		input    rune
+		expected string
+	}{
+		{'a', "L"},
+		{0x05d0, "R"},
+		{0x0627, "AL"},
+		{'1', "EN"},
+		{'\n', "B"},
+		{0x0301, "NSM"},
+		{0x202e, "RLO"},
+		{0x2066, "LRI"},
+		{0x2069, "PDI"},
+	}
+
+	for i, c := range cases {
+		if actual := bidiClass(c.input); actual != c.expected {
+			t.Errorf("[%d] expected: %s, actual %s", i, c.expected, actual)
+		}
+	}
+
+}
+
+func TestBidiTracker(t *testing.T) {
+
+	cases := []struct {
+		input    []rune
+		expected []string
+	}{
+		// 対応が取れていれば報告しないことを確認する
+		{[]rune{0x202e, 'a', 0x202c, 0x2067, 'b', 0x2069}, []string{}},
+		// 行末で閉じられていない制御文字を報告することを確認する(CVE-2021-42574)
+		{[]rune{'a', 0x202e, 'b', '\n'}, []string{"-:1:2: unterminated bidi control U+202E RLO"}},
+		// 入れ子になった制御文字を報告することを確認する
+		{[]rune{0x2066, 0x202b, 0x2069}, []string{"-:1:2: nested bidi control U+202B RLE inside U+2066 LRI"}},
+		// PDF は分離を閉じないことを確認する
+		{[]rune{0x2068, 0x202c, 0x2069}, []string{"-:1:2: unmatched bidi control U+202C PDF"}},
+		// 改行で状態が初期化されることを確認する
+		{[]rune{0x202d, '\n', 0x202c}, []string{
+			"-:1:1: unterminated bidi control U+202D LRO",
+			"-:2:1: unmatched bidi control U+202C PDF",
+		}},
+	}
+
+	for i, c := range cases {
+		tracker := bidiTracker{}
+		actual := []string{}
+		line, column := int64(1), int64(1)
+		for _, r := range c.input {
+			token := NewToken(r, TypeOk, nil)
+			token.Line, token.Column = line, column
+			actual = append(actual, tracker.add("-", token)...)
+			if r == '\n' {
+				line, column = line+1, 1
+			} else {
+				column++
+			}
+		}
+		actual = append(actual, tracker.flush("-")...)
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("[%d] expected: %v, actual %v", i, c.expected, actual)
+		}
+	}
+
+}
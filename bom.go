@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+)
+
+var (
+	utf32BeBOM = []byte{0x00, 0x00, 0xfe, 0xff}
+	utf32LeBOM = []byte{0xff, 0xfe, 0x00, 0x00}
+	utf16BeBOM = []byte{0xfe, 0xff}
+	utf16LeBOM = []byte{0xff, 0xfe}
+)
+
+// detectByteOrder は入力の先頭にあるBOMからバイトオーダーを判定する
+// BOMが存在しないときは nil を返す
+func detectByteOrder(reader *bufio.Reader, bit int) binary.ByteOrder {
+	if bit == 16 {
+		bs, _ := reader.Peek(2)
+		if bytes.Equal(bs, utf16BeBOM) {
+			return binary.BigEndian
+		} else if bytes.Equal(bs, utf16LeBOM) {
+			return binary.LittleEndian
+		}
+	} else if bit == 32 {
+		bs, _ := reader.Peek(4)
+		if bytes.Equal(bs, utf32BeBOM) {
+			return binary.BigEndian
+		} else if bytes.Equal(bs, utf32LeBOM) {
+			return binary.LittleEndian
+		}
+	}
+	return nil
+}
+
+// NewParserWithBOM は先頭のBOMに従ってバイトオーダーを選択した Parser を返す
+// BOMが存在しないときは defaultByteOrder を使用する
+func NewParserWithBOM(reader *bufio.Reader, bit int, defaultByteOrder binary.ByteOrder) Parser {
+	byteOrder := detectByteOrder(reader, bit)
+	if byteOrder == nil {
+		byteOrder = defaultByteOrder
+	}
+	return NewParser(reader, bit, byteOrder)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestNewParserWithBOM(t *testing.T) {
+
+	cases := []struct {
+		input    []byte
+		bit      int
+		expected []*Token
+	}{
+		// BOMが存在しないときは既定のバイトオーダーを使用することを確認する
+		{
+			input: []byte{0x00, 0x61},
+			bit:   16,
+			expected: []*Token{
+				withPosition(NewToken('a', TypeOk, []byte{0x00, 0x61}), 0, 0, 1, 1),
+			},
+		},
+		// UTF-16 のBOMに従ってバイトオーダーが選択されることを確認する
+		{
+			input: []byte{0xff, 0xfe, 0x61, 0x00},
+			bit:   16,
+			expected: []*Token{
+				withPosition(NewToken(0xfeff, TypeByteOrderMark, []byte{0xff, 0xfe}), 0, 0, 1, 1),
+				withPosition(NewToken('a', TypeOk, []byte{0x61, 0x00}), 2, 1, 1, 1),
+			},
+		},
+		{
+			input: []byte{0xfe, 0xff, 0x00, 0x61},
+			bit:   16,
+			expected: []*Token{
+				withPosition(NewToken(0xfeff, TypeByteOrderMark, []byte{0xfe, 0xff}), 0, 0, 1, 1),
+				withPosition(NewToken('a', TypeOk, []byte{0x00, 0x61}), 2, 1, 1, 1),
+			},
+		},
+		// UTF-32 のBOMに従ってバイトオーダーが選択されることを確認する
+		{
+			input: []byte{0xff, 0xfe, 0x00, 0x00, 0x61, 0x00, 0x00, 0x00},
+			bit:   32,
+			expected: []*Token{
+				withPosition(NewToken(0xfeff, TypeByteOrderMark, []byte{0xff, 0xfe, 0x00, 0x00}), 0, 0, 1, 1),
+				withPosition(NewToken('a', TypeOk, []byte{0x61, 0x00, 0x00, 0x00}), 4, 1, 1, 1),
+			},
+		},
+		{
+			input: []byte{0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x00, 0x61},
+			bit:   32,
+			expected: []*Token{
+				withPosition(NewToken(0xfeff, TypeByteOrderMark, []byte{0x00, 0x00, 0xfe, 0xff}), 0, 0, 1, 1),
+				withPosition(NewToken('a', TypeOk, []byte{0x00, 0x00, 0x00, 0x61}), 4, 1, 1, 1),
+			},
+		},
+		// 先頭の BOM は桁を占めず、続く文字が1桁目になることを確認する
+		{
+			input: []byte{0xef, 0xbb, 0xbf, 0x61, 0xff},
+			bit:   8,
+			expected: []*Token{
+				withPosition(NewToken(0xfeff, TypeByteOrderMark, []byte{0xef, 0xbb, 0xbf}), 0, 0, 1, 1),
+				withPosition(NewToken('a', TypeOk, []byte{0x61}), 3, 3, 1, 1),
+				withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xff}), 4, 4, 1, 2),
+			},
+		},
+		// 先頭以外に表れた U+FEFF が TypeMisplacedByteOrderMark になり、桁を占めることを確認する
+		{
+			input: []byte{0xff, 0xfe, 0x61, 0x00, 0xff, 0xfe},
+			bit:   16,
+			expected: []*Token{
+				withPosition(NewToken(0xfeff, TypeByteOrderMark, []byte{0xff, 0xfe}), 0, 0, 1, 1),
+				withPosition(NewToken('a', TypeOk, []byte{0x61, 0x00}), 2, 1, 1, 1),
+				withPosition(NewToken(0xfeff, TypeMisplacedByteOrderMark, []byte{0xff, 0xfe}), 4, 2, 1, 2),
+			},
+		},
+	}
+
+	for i, c := range cases {
+		reader := bufio.NewReader(bytes.NewReader(c.input))
+		parser := NewParserWithBOM(reader, c.bit, binary.BigEndian)
+
+		for j, expected := range c.expected {
+			actual, err := parser.parse()
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("[%d,%d] expected: %#v, actual %#v", i, j, expected, actual)
+			}
+			if err != nil {
+				t.Errorf("[%d,%d] unexpected error: %#v", i, j, err)
+			}
+		}
+		if actual, err := parser.parse(); actual != nil || err != io.EOF {
+			t.Errorf("[%d] expected: EOF, actual %#v, %#v", i, actual, err)
+		}
+	}
+
+}
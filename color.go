@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"unicode"
+)
+
+const (
+	ansiReset     = "\x1b[0m"
+	ansiDim       = "\x1b[2m"
+	ansiRed       = "\x1b[31m"
+	ansiYellow    = "\x1b[33m"
+	ansiHighlight = "\x1b[7;36m"
+)
+
+// useColor は -color の値から色を付けるかどうかを返す。値が不正なときは ok が false になる
+// auto では NO_COLOR が設定されておらず、file が端末のときに色を付ける
+func useColor(mode string, file *os.File) (color bool, ok bool) {
+	switch mode {
+	case "always":
+		return true, true
+	case "never":
+		return false, true
+	case "auto":
+		return os.Getenv("NO_COLOR") == "" && isTerminal(file), true
+	}
+	return false, false
+}
+
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// tokenColor は Token の種類に応じた色を返す。色を付けないときは空文字列を返す
+// 不正な Token のうち、冗長な形式と警告は黄色、それ以外の誤りは赤にする
+func tokenColor(t *Token) string {
+	if !t.IsValid() {
+		switch t.Type {
+		case TypeRedundantEncoding, TypeIncompleteSurrogatePair, TypeCESU8, TypeMisplacedByteOrderMark:
+			return ansiYellow
+		}
+		return ansiRed
+	}
+	if t.IsCharacter() && unicode.IsControl(t.Rune) {
+		return ansiDim
+	}
+	return ""
+}
+
+// colorize は s を ANSI エスケープシーケンスで囲む
+func colorize(s string, color string) string {
+	if color == "" {
+		return s
+	}
+	return color + s + ansiReset
+}
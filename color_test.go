@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestUseColor(t *testing.T) {
+
+	// 通常のファイルは端末ではないため、auto では色を付けないことを確認する
+	file, err := ioutil.TempFile("", "color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	cases := []struct {
+		mode    string
+		noColor string
+		color   bool
+		ok      bool
+	}{
+		{"always", "", true, true},
+		{"always", "1", true, true},
+		{"never", "", false, true},
+		{"auto", "", false, true},
+		{"auto", "1", false, true},
+		{"sometimes", "", false, false},
+	}
+
+	for i, c := range cases {
+		os.Setenv("NO_COLOR", c.noColor)
+		color, ok := useColor(c.mode, file)
+		if color != c.color || ok != c.ok {
+			t.Errorf("[%d] expected: %v %v, actual %v %v", i, c.color, c.ok, color, ok)
+		}
+	}
+	os.Unsetenv("NO_COLOR")
+
+}
+
+func TestTextFormatterColor(t *testing.T) {
+
+	tokens := []*Token{
+		NewToken('a', TypeOk, []byte{0x61}),
+		NewToken(0, TypeInvalidByteSequence, []byte{0xff}),
+		NewToken('a', TypeRedundantEncoding, []byte{0xc1, 0xa1}),
+		NewToken('\n', TypeOk, []byte{0x0a}),
+	}
+	expected := "00000000\ta\tU+0061\t61\tLATIN SMALL LETTER A\n" +
+		"00000000\t\x1b[31m\t\tff\t\x1b[0m\n" +
+		"00000000\t\x1b[33ma\tU+0061\tc1 a1\t[Redundant encoding]LATIN SMALL LETTER A\x1b[0m\n" +
+		"00000000\t\x1b[7;36mLF\x1b[0m\x1b[2m\tU+000A\t0a\t<control> LINE FEED\x1b[0m\n"
+
+	buf := bytes.Buffer{}
+	f := newFormatter("text", &buf, &bytes.Buffer{}, options{color: true, highlight: true})
+	for _, token := range tokens {
+		f.write("-", token)
+	}
+
+	// 不正なバイト列は赤、冗長な符号化は黄色、制御文字は薄く表示されることを確認する
+	if buf.String() != expected {
+		t.Errorf("expected: %q, actual %q", expected, buf.String())
+	}
+
+}
+
+func TestTokenColor(t *testing.T) {
+
+	cases := []struct {
+		token    *Token
+		expected string
+	}{
+		{NewToken('a', TypeOk, nil), ""},
+		{NewToken(0xfeff, TypeByteOrderMark, nil), ""},
+		{NewToken(0, TypeEscapeSequence, nil), ""},
+		{NewToken('\n', TypeOk, nil), ansiDim},
+		{NewToken(0, TypeInvalidByteSequence, nil), ansiRed},
+		{NewToken(0xdc00, TypeSurrogateInUTF8, nil), ansiRed},
+		{NewToken(0xd800, TypeSurrogateInUTF32, nil), ansiRed},
+		{NewToken(0x110000, TypeOutOfRange, nil), ansiRed},
+		{NewToken(0x200000, TypeObsoleteSequence, nil), ansiRed},
+		{NewToken(0, TypeUnterminatedShiftState, nil), ansiRed},
+		{NewToken('a', TypeRedundantEncoding, nil), ansiYellow},
+		{NewToken(0, TypeIncompleteSurrogatePair, nil), ansiYellow},
+		{NewToken(0x10000, TypeCESU8, nil), ansiYellow},
+		{NewToken(0xfeff, TypeMisplacedByteOrderMark, nil), ansiYellow},
+	}
+
+	// 正しくない Token の種類はすべて色が付くことを確認する
+	for i, c := range cases {
+		if actual := tokenColor(c.token); actual != c.expected {
+			t.Errorf("[%d] expected: %q, actual %q", i, c.expected, actual)
+		}
+	}
+
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// skeleton は UTS #39 の skeleton を返す。NFD に分解した各文字を原型に置き換え、もう一度 NFD に分解する
+func skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if p, ok := confusablePrototypes[r]; ok {
+			b.WriteString(p)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
+
+func isASCIIAlnum(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// IsASCIIConfusable は ASCII 以外の文字が ASCII の文字列と見分けにくいかどうかを返す
+func (t *Token) IsASCIIConfusable() bool {
+	if !t.IsCharacter() || t.Rune <= unicode.MaxASCII || t.Skeleton == string(t.Rune) {
+		return false
+	}
+	return isASCIIAlnum(t.Skeleton)
+}
+
+// confusableColumn は skeleton が文字自身と異なるときに skeleton とその符号位置を返す
+func confusableColumn(t *Token) string {
+	if !t.IsCharacter() || t.Skeleton == string(t.Rune) {
+		return ""
+	}
+	column := fmt.Sprintf("skeleton: %s (%s)", t.Skeleton, codePoints(t.Skeleton))
+	if t.MixedScript {
+		column += ", mixed-script confusable"
+	}
+	return column
+}
+
+type parseResult struct {
+	token *Token
+	err   error
+}
+
+// confusableParser は Token に skeleton を付け、複数の用字が混在する単語の中で
+// ASCII と見分けにくい文字に印を付ける。単語の終わりまで先読みするため、Token は単語ごとに返す
+type confusableParser struct {
+	parser  Parser
+	pending []parseResult
+}
+
+func newConfusableParser(parser Parser) *confusableParser {
+	return &confusableParser{parser: parser}
+}
+
+func (p *confusableParser) parse() (*Token, error) {
+	if len(p.pending) == 0 {
+		p.readWord()
+	}
+	result := p.pending[0]
+	p.pending = p.pending[1:]
+	return result.token, result.err
+}
+
+// readWord は単語を構成しない Token かエラーが現れるまで読み込む
+func (p *confusableParser) readWord() {
+	scripts := map[string]bool{}
+	words := 0
+	for {
+		token, err := p.parser.parse()
+		p.pending = append(p.pending, parseResult{token, err})
+		if token != nil && token.IsCharacter() {
+			token.Skeleton = skeleton(string(token.Rune))
+		}
+		if !isWordToken(token) {
+			break
+		}
+		words++
+		if script := scriptName(token.Rune); script != "Common" && script != "Inherited" {
+			scripts[script] = true
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	if len(scripts) < 2 {
+		return
+	}
+	for _, result := range p.pending[:words] {
+		if result.token.IsASCIIConfusable() {
+			result.token.MixedScript = true
+		}
+	}
+}
+
+func isWordToken(t *Token) bool {
+	if t == nil || !t.IsCharacter() {
+		return false
+	}
+	return unicode.IsLetter(t.Rune) || unicode.IsMark(t.Rune) || unicode.IsNumber(t.Rune)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestSkeleton(t *testing.T) {
+
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"a", "a"},
+		// キリル文字とギリシャ文字は ASCII の原型に置き換えられることを確認する
+		{"\u0440\u0430\u0443\u0440\u0430l", "paypal"},
+		{"\u0391\u039f", "AO"},
+		// ASCII 同士でも見分けにくい文字は同じ skeleton になることを確認する
+		{"I1l|", "llll"},
+		{"0", "O"},
+		// 全角文字や数学用英数字記号も ASCII の原型に置き換えられることを確認する
+		{"\uff41\uff29", "al"},
+		{"\U0001d41a", "a"},
+		// ラテン文字、ギリシャ文字、キリル文字以外の用字も置き換えられることを確認する
+		{"\ua4d0\u2c9f\U00010322\u06f5", "BoXo"},
+		// 複数の文字からなる原型に置き換えられることを確認する
+		{"\ufb01", "fi"},
+		// 結合文字は NFD に分解されたまま残ることを確認する
+		{"\u00e9", "e\u0301"},
+		{"\u3042", "\u3042"},
+	}
+
+	for i, c := range cases {
+		if actual := skeleton(c.input); actual != c.expected {
+			t.Errorf("[%d] expected: %q, actual %q", i, c.expected, actual)
+		}
+	}
+
+}
+
+func TestConfusableParser(t *testing.T) {
+
+	cases := []struct {
+		input    string
+		expected []bool
+	}{
+		// ラテン文字の単語に含まれるキリル文字に印が付くことを確認する
+		{"p\u0430y", []bool{false, true, false}},
+		// 単語の先頭の文字にも印が付くことを確認する
+		{"\u0430pp", []bool{true, false, false}},
+		// 1つの用字だけの単語には印が付かないことを確認する
+		{"\u0441\u043e\u0440", []bool{false, false, false}},
+		// 空白で区切られた単語は別々に判定されることを確認する
+		{"a \u0430", []bool{false, false, false}},
+		// 数字と記号は用字の混在に数えないことを確認する
+		{"\u04301", []bool{false, false}},
+	}
+
+	for i, c := range cases {
+		parser := newConfusableParser(NewParser(bufio.NewReader(bytes.NewReader([]byte(c.input))), 8, nil))
+		actual := []bool{}
+		for {
+			token, err := parser.parse()
+			if token != nil {
+				actual = append(actual, token.MixedScript)
+			}
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("[%d] unexpected error: %v", i, err)
+			}
+		}
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("[%d] expected: %v, actual %v", i, c.expected, actual)
+		}
+	}
+
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"unicode"
+)
+
+type detectCandidate struct {
+	charset   string
+	bit       int
+	byteOrder binary.ByteOrder
+}
+
+// 同点のときは先に並んでいる文字コードを優先する
+var detectCandidates = []detectCandidate{
+	{"UTF-8", 8, nil},
+	{"UTF-16LE", 16, binary.LittleEndian},
+	{"UTF-16BE", 16, binary.BigEndian},
+	{"UTF-32LE", 32, binary.LittleEndian},
+	{"UTF-32BE", 32, binary.BigEndian},
+}
+
+// detectEncoding は reader のバッファを先読みして最も尤もらしい文字コードと確信度(0-1)を返す
+func detectEncoding(reader *bufio.Reader) (string, float64) {
+	sample, _ := reader.Peek(reader.Size())
+	if len(sample) == 0 {
+		return "UTF-8", 0
+	}
+
+	if bytes.HasPrefix(sample, utf32LeBOM) {
+		return "UTF-32LE", 1
+	} else if bytes.HasPrefix(sample, utf32BeBOM) {
+		return "UTF-32BE", 1
+	} else if bytes.HasPrefix(sample, []byte{0xef, 0xbb, 0xbf}) {
+		return "UTF-8", 1
+	} else if bytes.HasPrefix(sample, utf16LeBOM) {
+		return "UTF-16LE", 1
+	} else if bytes.HasPrefix(sample, utf16BeBOM) {
+		return "UTF-16BE", 1
+	}
+
+	charset, best, second := "UTF-8", -1.0, 0.0
+	for _, c := range detectCandidates {
+		// 自然な文章では不正なバイト列がほとんど表れないため、有効率は二乗して強く効かせる
+		rate := validityRate(sample, c)
+		score := rate * rate * nulPatternRate(sample, c) * byteOrderRate(sample, c)
+		if score > best {
+			charset, best, second = c.charset, score, best
+		} else if score > second {
+			second = score
+		}
+	}
+	// 確信度は次点の候補との差とし、紛らわしい入力では低くなるようにする
+	if second < 0 {
+		second = 0
+	}
+	return charset, best - second
+}
+
+// validityRate は sample のうち表示可能な文字として解釈できたバイトの割合を返す
+func validityRate(sample []byte, c detectCandidate) float64 {
+	parser := NewParser(bufio.NewReader(bytes.NewReader(sample)), c.bit, c.byteOrder)
+
+	valid := 0
+	for {
+		token, err := parser.parse()
+		if token != nil && isPlausible(token) {
+			valid += len(token.Bytes)
+		}
+		if err != nil {
+			break
+		}
+	}
+	return float64(valid) / float64(len(sample))
+}
+
+func isPlausible(t *Token) bool {
+	if t.Type == TypeByteOrderMark {
+		return true
+	}
+	return t.Type == TypeOk && (unicode.IsPrint(t.Rune) || unicode.IsSpace(t.Rune))
+}
+
+// nulPatternRate は 0x00 のバイトのうち、UTF-16/UTF-32 の最下位バイト以外の位置に表れたものの割合を返す
+// 0x00 を含まない入力では判断材料が乏しいため、UTF-16/UTF-32 をわずかに不利な 0.9 とする
+func nulPatternRate(sample []byte, c detectCandidate) float64 {
+	if c.bit == 8 {
+		return 1
+	}
+
+	unit := c.bit / 8
+	total, expected := 0, 0
+	for i, b := range sample {
+		if b != 0 {
+			continue
+		}
+		total++
+		pos := i % unit
+		if c.byteOrder == binary.LittleEndian {
+			pos = unit - 1 - pos
+		}
+		// 最下位バイト以外に 0x00 が表れることを期待する
+		if pos < unit-1 {
+			expected++
+		}
+	}
+
+	if total == 0 {
+		return 0.9
+	}
+	return float64(expected) / float64(total)
+}
+
+// byteOrderRate は最上位バイトの位置に同じ値が偏って表れている度合いを 0.5-1 で返す
+// 同じ用字の文字が続く文章では上位バイトがほとんど変わらないため、0x00 を含まない入力でも LE/BE を見分けられる
+func byteOrderRate(sample []byte, c detectCandidate) float64 {
+	if c.bit == 8 {
+		return 1
+	}
+
+	unit := c.bit / 8
+	high := 0
+	if c.byteOrder == binary.LittleEndian {
+		high = unit - 1
+	}
+	// 漢字のように上位バイトがばらつく文章でも UTF-16/UTF-32 を大きく不利にしないよう、影響は半分に抑える
+	return (1 + concentration(sample, unit, high)) / 2
+}
+
+// concentration は unit バイトごとの pos 番目のバイトのうち、最も多く表れた値の割合を返す
+func concentration(sample []byte, unit int, pos int) float64 {
+	counts := map[byte]int{}
+	total, max := 0, 0
+	for i := pos; i+unit-pos <= len(sample); i += unit {
+		counts[sample[i]]++
+		total++
+		if counts[sample[i]] > max {
+			max = counts[sample[i]]
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(max) / float64(total)
+}
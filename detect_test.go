@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestDetectEncoding(t *testing.T) {
+
+	cases := []struct {
+		input    []byte
+		expected string
+	}{
+		// BOMから判定できることを確認する
+		{[]byte{0xef, 0xbb, 0xbf, 0x61}, "UTF-8"},
+		{[]byte{0xff, 0xfe, 0x61, 0x00}, "UTF-16LE"},
+		{[]byte{0xfe, 0xff, 0x00, 0x61}, "UTF-16BE"},
+		{[]byte{0xff, 0xfe, 0x00, 0x00, 0x61, 0x00, 0x00, 0x00}, "UTF-32LE"},
+		{[]byte{0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x00, 0x61}, "UTF-32BE"},
+		// BOMがないとき 0x00 の位置と有効率から判定できることを確認する
+		{[]byte("hello, world\n"), "UTF-8"},
+		{[]byte{0xe3, 0x81, 0x82, 0xe3, 0x81, 0x84}, "UTF-8"},
+		{[]byte{0x68, 0x00, 0x69, 0x00, 0x42, 0x30}, "UTF-16LE"},
+		{[]byte{0x00, 0x68, 0x00, 0x69, 0x30, 0x42}, "UTF-16BE"},
+		{[]byte{0x68, 0x00, 0x00, 0x00, 0x42, 0x30, 0x00, 0x00}, "UTF-32LE"},
+		{[]byte{0x00, 0x00, 0x00, 0x68, 0x00, 0x00, 0x30, 0x42}, "UTF-32BE"},
+		// 0x00 を含まないときは上位バイトの偏りから LE/BE を判定できることを確認する(あいうえおカタカナ)
+		{[]byte{0x30, 0x42, 0x30, 0x44, 0x30, 0x46, 0x30, 0x48, 0x30, 0x4a, 0x30, 0xab, 0x30, 0xbf, 0x30, 0xab, 0x30, 0xca}, "UTF-16BE"},
+		{[]byte{0x42, 0x30, 0x44, 0x30, 0x46, 0x30, 0x48, 0x30, 0x4a, 0x30, 0xab, 0x30, 0xbf, 0x30, 0xab, 0x30, 0xca, 0x30}, "UTF-16LE"},
+	}
+
+	for i, c := range cases {
+		reader := bufio.NewReader(bytes.NewReader(c.input))
+		actual, confidence := detectEncoding(reader)
+		if actual != c.expected {
+			t.Errorf("[%d] expected: %s, actual %s (%.2f)", i, c.expected, actual, confidence)
+		}
+
+		// 先読みした内容が消費されていないことを確認する
+		if rest, _ := reader.Peek(len(c.input)); !bytes.Equal(rest, c.input) {
+			t.Errorf("[%d] input consumed: %#v", i, rest)
+		}
+	}
+
+}
+
+func TestDetectEncodingConfidence(t *testing.T) {
+
+	cases := []struct {
+		input    []byte
+		expected string
+	}{
+		{[]byte{}, "0.00"},
+		// BOMがあるときは確信度が 1 になることを確認する
+		{[]byte{0xfe, 0xff, 0x00, 0x61}, "1.00"},
+		// 確信度は次点の候補との差になることを確認する
+		{[]byte("hello, world\n"), "0.49"},
+		{[]byte{0x68, 0x00, 0x69, 0x00, 0x42, 0x30}, "0.39"},
+		// 他の文字コードとしても解釈できる入力では確信度が低くなることを確認する
+		{[]byte{0x30, 0x42, 0x30, 0x44, 0x30, 0x46, 0x30, 0x48, 0x30, 0x4a, 0x30, 0xab, 0x30, 0xbf, 0x30, 0xab, 0x30, 0xca}, "0.30"},
+	}
+
+	for i, c := range cases {
+		_, confidence := detectEncoding(bufio.NewReader(bytes.NewReader(c.input)))
+		if actual := fmt.Sprintf("%.2f", confidence); actual != c.expected {
+			t.Errorf("[%d] expected: %s, actual %s", i, c.expected, actual)
+		}
+	}
+
+}
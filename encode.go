@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/runenames"
+)
+
+var encodeCharsets = []struct {
+	charset   string
+	bit       int
+	byteOrder binary.ByteOrder
+}{
+	{"UTF-8", 8, nil},
+	{"UTF-16BE", 16, binary.BigEndian},
+	{"UTF-16LE", 16, binary.LittleEndian},
+	{"UTF-32BE", 32, binary.BigEndian},
+	{"UTF-32LE", 32, binary.LittleEndian},
+}
+
+// runEncode は encode サブコマンドを実行し、終了ステータスを返す
+func runEncode(args []string) int {
+	flags := flag.NewFlagSet("encode", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s encode code-point ...\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "code-point is U+XXXX, 0xXXXX, a character name or literal characters.")
+		fmt.Fprintln(flags.Output(), "An argument of two or more characters is looked up in this order: U+XXXX or 0xXXXX, character name,")
+		fmt.Fprintln(flags.Output(), "name alias (correction, control, alternate, figment), then literal characters.")
+		fmt.Fprintln(flags.Output(), "Abbreviations such as LF or FF are not looked up, so they are read as literal characters.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	status := 0
+	first := true
+	for _, arg := range flags.Args() {
+		runes, err := parseCodePoints(arg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			status = 1
+			continue
+		}
+
+		for _, r := range runes {
+			if !first {
+				fmt.Println()
+			}
+			first = false
+			for _, c := range encodeCharsets {
+				token := NewToken(r, TypeOk, encodeRune(r, c.bit, c.byteOrder))
+				fmt.Printf("%s\t%s\n", c.charset, token.Columns())
+			}
+		}
+	}
+	return status
+}
+
+// parseCodePoints は U+XXXX、0xXXXX、文字の名前、文字そのものの順に解釈して符号位置を返す
+func parseCodePoints(arg string) ([]rune, error) {
+	upper := strings.ToUpper(arg)
+	if strings.HasPrefix(upper, "U+") || strings.HasPrefix(upper, "0X") {
+		n, err := strconv.ParseUint(arg[2:], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code point: %s", arg)
+		}
+		r := rune(n)
+		if !utf8.ValidRune(r) {
+			return nil, fmt.Errorf("not a Unicode scalar value: %s", arg)
+		}
+		return []rune{r}, nil
+	}
+
+	if utf8.RuneCountInString(arg) > 1 {
+		if r, ok := lookupName(arg); ok {
+			return []rune{r}, nil
+		}
+	}
+	if !utf8.ValidString(arg) {
+		return nil, fmt.Errorf("invalid UTF-8 string: %q", arg)
+	}
+	return []rune(arg), nil
+}
+
+// lookupName は文字の名前または別名から符号位置を探す
+// LF や FF のような略称は英字の並びと区別できないため、別名のうち abbreviation は対象にしない
+func lookupName(name string) (rune, bool) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if runenames.Name(r) == name {
+			return r, true
+		}
+	}
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		for _, a := range nameAliases[r] {
+			if a.kind != "abbreviation" && a.alias == name {
+				return r, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func encodeRune(r rune, bit int, byteOrder binary.ByteOrder) []byte {
+	if bit == 8 {
+		bs := make([]byte, utf8.RuneLen(r))
+		utf8.EncodeRune(bs, r)
+		return bs
+	} else if bit == 16 {
+		units := []uint16{uint16(r)}
+		if r >= 0x10000 {
+			r1, r2 := utf16.EncodeRune(r)
+			units = []uint16{uint16(r1), uint16(r2)}
+		}
+		bs := make([]byte, len(units)*2)
+		for i, u := range units {
+			byteOrder.PutUint16(bs[i*2:], u)
+		}
+		return bs
+	}
+	bs := make([]byte, 4)
+	byteOrder.PutUint32(bs, uint32(r))
+	return bs
+}
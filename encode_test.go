@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestParseCodePoints(t *testing.T) {
+
+	cases := []struct {
+		input    string
+		expected []rune
+		err      bool
+	}{
+		{"U+1F600", []rune{0x1f600}, false},
+		{"u+41", []rune{'A'}, false},
+		{"0x1F600", []rune{0x1f600}, false},
+		{"grinning face", []rune{0x1f600}, false},
+		{"line feed", []rune{'\n'}, false},
+		{"あ", []rune{'あ'}, false},
+		{"ab", []rune{'a', 'b'}, false},
+		// 略称は別名として探さず、文字そのものとして扱うことを確認する
+		{"FF", []rune{'F', 'F'}, false},
+		{"LF", []rune{'L', 'F'}, false},
+		{"SP", []rune{'S', 'P'}, false},
+		{"PAD", []rune{'P', 'A', 'D'}, false},
+		// 略称以外の別名は探すことを確認する
+		{"byte order mark", []rune{0xfeff}, false},
+		{"U+D800", nil, true},
+		{"U+110000", nil, true},
+		{"U+XYZ", nil, true},
+	}
+
+	for i, c := range cases {
+		actual, err := parseCodePoints(c.input)
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("[%d] expected: %#v, actual %#v", i, c.expected, actual)
+		}
+		if (err != nil) != c.err {
+			t.Errorf("[%d] unexpected error: %#v", i, err)
+		}
+	}
+
+}
+
+func TestEncodeRune(t *testing.T) {
+
+	// エンコードしたバイト列を各 Parser で元の文字に戻せることを確認する
+	for _, r := range []rune{'a', 'À', 'あ', '𩸽'} {
+		for _, c := range encodeCharsets {
+			bs := encodeRune(r, c.bit, c.byteOrder)
+			parser := NewParser(bufio.NewReader(bytes.NewReader(bs)), c.bit, c.byteOrder)
+			token, err := parser.parse()
+			if err != nil || token.Type != TypeOk || token.Rune != r || !bytes.Equal(token.Bytes, bs) {
+				t.Errorf("[%s,%U] unexpected result: %#v, %#v", c.charset, r, token, err)
+			}
+		}
+	}
+
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// formatter は Token を出力形式に従って書き出す
+type formatter interface {
+	begin() error
+	write(path string, token *Token) error
+	note(msg string) error
+	end() error
+}
+
+// checkGraphemes は -graphemes と組み合わせられない出力形式やオプションが指定されているときにエラーを返す
+// クラスタは字下げした行で表すため、テキスト形式でのみ出力できる
+func checkGraphemes(format string, opts options) error {
+	if !opts.graphemes {
+		return nil
+	}
+	if format != "text" {
+		return fmt.Errorf("-graphemes requires -format text")
+	}
+	if opts.errorsOnly {
+		return fmt.Errorf("-graphemes cannot be used with -errors-only")
+	}
+	return nil
+}
+
+// errw は JSON や CSV、-errors-only など、補足のメッセージを混ぜると壊れる形式で note の出力先に使う
+// 対応していない形式、またはテキスト以外の形式や -errors-only で -graphemes を指定したときは nil を返す
+func newFormatter(format string, w io.Writer, errw io.Writer, opts options) formatter {
+	if checkGraphemes(format, opts) != nil {
+		return nil
+	}
+
+	switch format {
+	case "text":
+		if opts.errorsOnly {
+			return &lintFormatter{w: w, errw: errw, position: opts.position, color: opts.color}
+		} else if opts.graphemes {
+			return &graphemeFormatter{text: &textFormatter{w: w, opts: opts}}
+		}
+		return &textFormatter{w: w, opts: opts}
+	case "table":
+		return &tableFormatter{w: w, opts: opts}
+	case "hexdump":
+		return &hexdumpFormatter{w: w, opts: opts}
+	case "json":
+		return &jsonFormatter{w: w, errw: errw}
+	case "jsonl":
+		return &jsonlFormatter{w: w, errw: errw}
+	case "csv":
+		return &csvFormatter{w: csv.NewWriter(w), errw: errw}
+	}
+	return nil
+}
+
+type textFormatter struct {
+	w    io.Writer
+	opts options
+}
+
+func (f *textFormatter) begin() error {
+	return nil
+}
+
+func (f *textFormatter) write(path string, token *Token) error {
+	_, err := fmt.Fprintln(f.w, f.line(token))
+	return err
+}
+
+func (f *textFormatter) line(token *Token) string {
+	line := token.String()
+	if f.opts.color {
+		line = fmt.Sprintf("%08x\t%s", token.Offset, f.colorColumns(token))
+	}
+	if f.opts.position {
+		line = token.Position() + "\t" + line
+	}
+	return line + propertyColumns(token, f.opts)
+}
+
+// colorColumns は Token の種類に応じて色を付けた Columns を返す。-highlight では記号を強調する
+func (f *textFormatter) colorColumns(token *Token) string {
+	columns := token.Columns()
+	color := tokenColor(token)
+	if !f.opts.highlight || !token.IsCharacter() || !isInvisible(token.Rune) {
+		return colorize(columns, color)
+	}
+
+	symbol := token.Symbol()
+	return colorize(symbol, ansiHighlight) + colorize(strings.TrimPrefix(columns, symbol), color)
+}
+
+// propertyColumns はオプションで指定された一般カテゴリ、用字、ブロック、双方向クラス、正規化、skeleton、別名の列を返す
+func propertyColumns(token *Token, opts options) string {
+	columns := ""
+	if opts.category {
+		columns += "\t"
+		if token.IsCharacter() {
+			columns += generalCategory(token.Rune)
+		}
+	}
+	if opts.script {
+		columns += "\t"
+		if token.IsCharacter() {
+			columns += scriptName(token.Rune)
+		}
+	}
+	if opts.block {
+		columns += "\t"
+		if token.IsCharacter() {
+			columns += blockName(token.Rune)
+		}
+	}
+	if opts.bidi {
+		columns += "\t"
+		if token.IsCharacter() {
+			columns += bidiClass(token.Rune)
+		}
+	}
+	if opts.normalization {
+		columns += "\t"
+		if token.IsCharacter() {
+			columns += normalizationColumn(string(token.Rune))
+		}
+	}
+	if opts.confusables {
+		columns += "\t" + confusableColumn(token)
+	}
+	if opts.aliases {
+		aliases := []string{}
+		for _, a := range token.NameAliases() {
+			aliases = append(aliases, a.kind+": "+a.alias)
+		}
+		columns += "\t" + strings.Join(aliases, ", ")
+	}
+	return columns
+}
+
+func (f *textFormatter) note(msg string) error {
+	_, err := fmt.Fprintln(f.w, msg)
+	return err
+}
+
+func (f *textFormatter) end() error {
+	return nil
+}
+
+type tokenRecord struct {
+	File      string        `json:"file,omitempty"`
+	Offset    int64         `json:"offset"`
+	Index     int64         `json:"index"`
+	Line      int64         `json:"line"`
+	Column    int64         `json:"column"`
+	CodePoint string        `json:"codepoint,omitempty"`
+	Bytes     string        `json:"bytes"`
+	Type      string        `json:"type"`
+	Label     string        `json:"label,omitempty"`
+	Name      string        `json:"name,omitempty"`
+	Category  string        `json:"category,omitempty"`
+	Alias     string        `json:"alias,omitempty"`
+	Aliases   []aliasRecord `json:"aliases,omitempty"`
+	Skeleton  string        `json:"skeleton,omitempty"`
+	Mixed     bool          `json:"mixed_script_confusable,omitempty"`
+}
+
+type aliasRecord struct {
+	Alias string `json:"alias"`
+	Type  string `json:"type"`
+}
+
+func newTokenRecord(path string, t *Token) tokenRecord {
+	record := tokenRecord{
+		Offset: t.Offset,
+		Index:  t.Index,
+		Line:   t.Line,
+		Column: t.Column,
+		Bytes:  t.Hex(),
+		Type:   t.TypeName(),
+		Label:  t.Label(),
+		Name:   t.Name(),
+		Alias:  t.Alias(),
+		Mixed:  t.MixedScript,
+	}
+	if path != "-" {
+		record.File = path
+	}
+	if t.HasRune() {
+		record.CodePoint = t.CodePoint()
+	}
+	if t.IsCharacter() {
+		record.Category = generalCategory(t.Rune)
+	}
+	if t.IsCharacter() && t.Skeleton != string(t.Rune) {
+		record.Skeleton = t.Skeleton
+	}
+	for _, a := range t.NameAliases() {
+		record.Aliases = append(record.Aliases, aliasRecord{Alias: a.alias, Type: a.kind})
+	}
+	return record
+}
+
+// encodeJSON は HTML の特殊文字をエスケープせずに JSON を生成する
+func encodeJSON(v interface{}) ([]byte, error) {
+	buf := bytes.Buffer{}
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// jsonFormatter はすべての Token を1つの配列として出力する
+type jsonFormatter struct {
+	w     io.Writer
+	errw  io.Writer
+	count int
+}
+
+func (f *jsonFormatter) begin() error {
+	_, err := io.WriteString(f.w, "[")
+	return err
+}
+
+func (f *jsonFormatter) write(path string, token *Token) error {
+	bs, err := encodeJSON(newTokenRecord(path, token))
+	if err != nil {
+		return err
+	}
+
+	sep := ",\n"
+	if f.count == 0 {
+		sep = "\n"
+	}
+	f.count++
+	_, err = fmt.Fprintf(f.w, "%s  %s", sep, bs)
+	return err
+}
+
+// note は出力を壊さないよう標準エラー出力に書き出す
+func (f *jsonFormatter) note(msg string) error {
+	_, err := fmt.Fprintln(f.errw, msg)
+	return err
+}
+
+func (f *jsonFormatter) end() error {
+	_, err := io.WriteString(f.w, "\n]\n")
+	return err
+}
+
+// jsonlFormatter は Token を1行に1つずつ JSON Lines として出力する
+type jsonlFormatter struct {
+	w    io.Writer
+	errw io.Writer
+}
+
+func (f *jsonlFormatter) begin() error {
+	return nil
+}
+
+func (f *jsonlFormatter) write(path string, token *Token) error {
+	bs, err := encodeJSON(newTokenRecord(path, token))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f.w, "%s\n", bs)
+	return err
+}
+
+func (f *jsonlFormatter) note(msg string) error {
+	_, err := fmt.Fprintln(f.errw, msg)
+	return err
+}
+
+func (f *jsonlFormatter) end() error {
+	return nil
+}
+
+var csvHeader = []string{
+	"file", "offset", "index", "line", "column", "char", "codepoint", "bytes", "type", "state", "label", "name", "alias",
+	"skeleton", "mixed_script_confusable",
+}
+
+// csvFormatter はヘッダ行に続けて1行に1つの Token を CSV として出力する
+type csvFormatter struct {
+	w    *csv.Writer
+	errw io.Writer
+}
+
+func (f *csvFormatter) begin() error {
+	return f.w.Write(csvHeader)
+}
+
+func (f *csvFormatter) write(path string, token *Token) error {
+	record := newTokenRecord(path, token)
+
+	// 記号ではなく文字そのものを出力し、カンマや改行は CSV のクォートで表現する
+	var char string
+	if token.IsCharacter() {
+		char = string(token.Rune)
+	}
+
+	return f.w.Write([]string{
+		record.File,
+		strconv.FormatInt(record.Offset, 10),
+		strconv.FormatInt(record.Index, 10),
+		strconv.FormatInt(record.Line, 10),
+		strconv.FormatInt(record.Column, 10),
+		char,
+		record.CodePoint,
+		record.Bytes,
+		record.Type,
+		token.State,
+		record.Label,
+		record.Name,
+		record.Alias,
+		record.Skeleton,
+		strconv.FormatBool(record.Mixed),
+	})
+}
+
+func (f *csvFormatter) note(msg string) error {
+	_, err := fmt.Fprintln(f.errw, msg)
+	return err
+}
+
+func (f *csvFormatter) end() error {
+	f.w.Flush()
+	return f.w.Error()
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestJSONFormatter(t *testing.T) {
+
+	tokens := []*Token{
+		withPosition(NewToken('<', TypeOk, []byte{0x3c}), 0, 0, 1, 1),
+		withPosition(NewToken('\n', TypeOk, []byte{0x0a}), 1, 1, 1, 2),
+		withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xff}), 2, 2, 2, 1),
+	}
+
+	cases := []struct {
+		format   string
+		path     string
+		expected string
+	}{
+		// JSON Lines では1行に1つの Token を出力し、HTML の特殊文字をエスケープしないことを確認する
+		{
+			format: "jsonl",
+			path:   "-",
+			expected: `{"offset":0,"index":0,"line":1,"column":1,"codepoint":"U+003C","bytes":"3c","type":"Ok","name":"LESS-THAN SIGN","category":"Sm"}
+{"offset":1,"index":1,"line":1,"column":2,"codepoint":"U+000A","bytes":"0a","type":"Ok","name":"<control>","category":"Cc","alias":"LINE FEED","aliases":[{"alias":"LINE FEED","type":"control"},{"alias":"NEW LINE","type":"control"},{"alias":"END OF LINE","type":"control"},{"alias":"LF","type":"abbreviation"},{"alias":"NL","type":"abbreviation"},{"alias":"EOL","type":"abbreviation"}]}
+{"offset":2,"index":2,"line":2,"column":1,"bytes":"ff","type":"InvalidByteSequence"}
+`,
+		},
+		// JSON では配列として出力し、ファイル名を含めることを確認する
+		{
+			format: "json",
+			path:   "a.txt",
+			expected: `[
+  {"file":"a.txt","offset":0,"index":0,"line":1,"column":1,"codepoint":"U+003C","bytes":"3c","type":"Ok","name":"LESS-THAN SIGN","category":"Sm"},
+  {"file":"a.txt","offset":1,"index":1,"line":1,"column":2,"codepoint":"U+000A","bytes":"0a","type":"Ok","name":"<control>","category":"Cc","alias":"LINE FEED","aliases":[{"alias":"LINE FEED","type":"control"},{"alias":"NEW LINE","type":"control"},{"alias":"END OF LINE","type":"control"},{"alias":"LF","type":"abbreviation"},{"alias":"NL","type":"abbreviation"},{"alias":"EOL","type":"abbreviation"}]},
+  {"file":"a.txt","offset":2,"index":2,"line":2,"column":1,"bytes":"ff","type":"InvalidByteSequence"}
+]
+`,
+		},
+		// CSV ではヘッダ行を出力し、カンマや改行を含む値をクォートすることを確認する
+		{
+			format: "csv",
+			path:   "-",
+			expected: `file,offset,index,line,column,char,codepoint,bytes,type,state,label,name,alias,skeleton,mixed_script_confusable
+,0,0,1,1,<,U+003C,3c,Ok,,,LESS-THAN SIGN,,,false
+,1,1,1,2,"
+",U+000A,0a,Ok,,,<control>,LINE FEED,,false
+,2,2,2,1,,,ff,InvalidByteSequence,,,,,,false
+`,
+		},
+	}
+
+	for i, c := range cases {
+		buf := bytes.Buffer{}
+		errBuf := bytes.Buffer{}
+		f := newFormatter(c.format, &buf, &errBuf, options{})
+		f.begin()
+		for _, token := range tokens {
+			if err := f.write(c.path, token); err != nil {
+				t.Fatalf("[%d] unexpected error: %v", i, err)
+			}
+		}
+		f.note("note")
+		f.end()
+
+		if buf.String() != c.expected {
+			t.Errorf("[%d] expected: %s, actual %s", i, c.expected, buf.String())
+		}
+		// note は出力を壊さないよう別の出力先に書き出すことを確認する
+		if errBuf.String() != "note\n" {
+			t.Errorf("[%d] expected: %q, actual %q", i, "note\n", errBuf.String())
+		}
+	}
+
+}
+
+func TestTextFormatterPropertyColumns(t *testing.T) {
+
+	tokens := []*Token{
+		NewToken('а', TypeOk, []byte{0xd0, 0xb0}),
+		NewToken(0x200b, TypeOk, []byte{0xe2, 0x80, 0x8b}),
+		withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xff}), 5, 5, 1, 3),
+	}
+	expected := "00000000\tа\tU+0430\td0 b0\tCYRILLIC SMALL LETTER A\tLl\tCyrillic\tCyrillic\t\n" +
+		"00000000\tZWSP\tU+200B\te2 80 8b\tZERO WIDTH SPACE\tCf\tCommon\tGeneral Punctuation\tabbreviation: ZWSP\n" +
+		"00000005\t\t\tff\t\t\t\t\t\n"
+
+	buf := bytes.Buffer{}
+	f := newFormatter("text", &buf, &bytes.Buffer{}, options{category: true, script: true, block: true, aliases: true})
+	for _, token := range tokens {
+		f.write("-", token)
+	}
+
+	// 一般カテゴリ、用字、ブロック、別名の列が追加され、不正なバイト列では空になることを確認する
+	if buf.String() != expected {
+		t.Errorf("expected: %q, actual %q", expected, buf.String())
+	}
+
+}
+
+func TestTextFormatterHighlight(t *testing.T) {
+
+	tokens := []*Token{
+		NewToken('a', TypeOk, []byte{0x61}),
+		NewToken(0x200b, TypeOk, []byte{0xe2, 0x80, 0x8b}),
+	}
+	expected := "00000000\ta\tU+0061\t61\tLATIN SMALL LETTER A\n" +
+		"00000000\t\x1b[7;36mZWSP\x1b[0m\tU+200B\te2 80 8b\tZERO WIDTH SPACE\n"
+
+	buf := bytes.Buffer{}
+	f := newFormatter("text", &buf, &bytes.Buffer{}, options{highlight: true, color: true})
+	for _, token := range tokens {
+		f.write("-", token)
+	}
+
+	// 空白文字と既定で無視される文字の記号だけが強調されることを確認する
+	if buf.String() != expected {
+		t.Errorf("expected: %q, actual %q", expected, buf.String())
+	}
+
+}
+
+func TestCSVFormatterConfusables(t *testing.T) {
+
+	token := NewToken(0x0430, TypeOk, []byte{0xd0, 0xb0})
+	token.Skeleton = "a"
+	token.MixedScript = true
+	expected := "file,offset,index,line,column,char,codepoint,bytes,type,state,label,name,alias,skeleton,mixed_script_confusable\n" +
+		",0,0,0,0,\u0430,U+0430,d0 b0,Ok,,,CYRILLIC SMALL LETTER A,,a,true\n"
+
+	buf := bytes.Buffer{}
+	f := newFormatter("csv", &buf, &bytes.Buffer{}, options{confusables: true})
+	f.begin()
+	f.write("-", token)
+	f.end()
+
+	// -confusables で設定した skeleton と用字の混在が列に含まれることを確認する
+	if buf.String() != expected {
+		t.Errorf("expected: %q, actual %q", expected, buf.String())
+	}
+
+}
+
+func TestTokenAlias(t *testing.T) {
+
+	cases := []struct {
+		input    rune
+		expected string
+	}{
+		{'a', ""},
+		{'\n', "LINE FEED"},
+		{0x07, "ALERT"},
+		{0x85, "NEXT LINE"},
+		// figment は制御文字の別名として扱わないことを確認する
+		{0x80, ""},
+		{0x81, ""},
+		{0x99, ""},
+	}
+
+	for i, c := range cases {
+		if actual := NewToken(c.input, TypeOk, nil).Alias(); actual != c.expected {
+			t.Errorf("[%d] expected: %q, actual %q", i, c.expected, actual)
+		}
+	}
+
+}
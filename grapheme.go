@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// graphemeBreakProperty は r の Grapheme_Cluster_Break プロパティを返す
+func graphemeBreakProperty(r rune) int {
+	// ハングル音節は LV(終声なし)と LVT(終声あり)に分かれる
+	if 0xac00 <= r && r <= 0xd7a3 {
+		if (r-0xac00)%28 == 0 {
+			return gcbLV
+		}
+		return gcbLVT
+	}
+
+	i := sort.Search(len(graphemeBreakRanges), func(i int) bool {
+		return graphemeBreakRanges[i].last >= r
+	})
+	if i < len(graphemeBreakRanges) && graphemeBreakRanges[i].first <= r {
+		return graphemeBreakRanges[i].property
+	}
+	return gcbOther
+}
+
+// graphemeBreaker は UAX #29 の拡張書記素クラスタの境界を判定する
+type graphemeBreaker struct {
+	started bool
+	prev    int
+	// 直前までに連続した Regional_Indicator の数が奇数かどうか
+	riOdd bool
+	// Extended_Pictographic Extend* が続いているかどうか
+	pictographic bool
+	// Extended_Pictographic Extend* ZWJ が続いているかどうか
+	pictographicZWJ bool
+}
+
+// isBoundary は token の直前がクラスタの境界かどうかを返し、状態を更新する
+func (g *graphemeBreaker) isBoundary(token *Token) bool {
+	prop := gcbControl
+	if token.IsCharacter() {
+		prop = graphemeBreakProperty(token.Rune)
+	}
+
+	boundary := !g.started || g.breakBetween(g.prev, prop)
+
+	g.pictographicZWJ = g.pictographic && prop == gcbZWJ
+	if prop == gcbExtendedPictographic {
+		g.pictographic = true
+	} else if prop != gcbExtend {
+		g.pictographic = false
+	}
+	if prop == gcbRegionalIndicator {
+		g.riOdd = !g.riOdd
+	} else {
+		g.riOdd = false
+	}
+	g.started = true
+	g.prev = prop
+
+	return boundary
+}
+
+func (g *graphemeBreaker) breakBetween(prev, next int) bool {
+	switch {
+	case prev == gcbCR && next == gcbLF: // GB3
+		return false
+	case prev == gcbControl || prev == gcbCR || prev == gcbLF: // GB4
+		return true
+	case next == gcbControl || next == gcbCR || next == gcbLF: // GB5
+		return true
+	case prev == gcbL && (next == gcbL || next == gcbV || next == gcbLV || next == gcbLVT): // GB6
+		return false
+	case (prev == gcbLV || prev == gcbV) && (next == gcbV || next == gcbT): // GB7
+		return false
+	case (prev == gcbLVT || prev == gcbT) && next == gcbT: // GB8
+		return false
+	case next == gcbExtend || next == gcbZWJ: // GB9
+		return false
+	case next == gcbSpacingMark: // GB9a
+		return false
+	case prev == gcbPrepend: // GB9b
+		return false
+	case g.pictographicZWJ && next == gcbExtendedPictographic: // GB11
+		return false
+	case prev == gcbRegionalIndicator && next == gcbRegionalIndicator && g.riOdd: // GB12, GB13
+		return false
+	}
+	return true // GB999
+}
+
+// graphemeFormatter は Token を拡張書記素クラスタごとにまとめて出力する
+type graphemeFormatter struct {
+	text    *textFormatter
+	breaker graphemeBreaker
+	cluster []*Token
+}
+
+func (f *graphemeFormatter) begin() error {
+	return nil
+}
+
+func (f *graphemeFormatter) write(path string, token *Token) error {
+	if f.breaker.isBoundary(token) {
+		if err := f.flush(); err != nil {
+			return err
+		}
+	}
+	f.cluster = append(f.cluster, token)
+	return nil
+}
+
+// flush はクラスタを1行で出力し、続けて構成する Token を字下げして出力する
+func (f *graphemeFormatter) flush() error {
+	if len(f.cluster) == 0 {
+		return nil
+	}
+
+	var s, chars strings.Builder
+	size := 0
+	for _, token := range f.cluster {
+		if token.IsCharacter() {
+			chars.WriteRune(token.Rune)
+		}
+		// 結合文字がつながるよう文字そのものを並べ、制御文字は記号で表す
+		if token.IsCharacter() && !unicode.IsControl(token.Rune) {
+			s.WriteRune(token.Rune)
+		} else {
+			s.WriteString(token.Symbol())
+		}
+		size += len(token.Bytes)
+	}
+
+	header := fmt.Sprintf("%08x\t%s\tcode points: %d, bytes: %d", f.cluster[0].Offset, s.String(), len(f.cluster), size)
+	// 文字をまたいだ合成を確認できるよう、クラスタ全体の正規化結果も出力する
+	if f.text.opts.normalization {
+		header += "\t" + normalizationColumn(chars.String())
+	}
+
+	lines := []string{header}
+	for _, token := range f.cluster {
+		lines = append(lines, "\t"+f.text.line(token))
+	}
+	f.cluster = nil
+
+	_, err := io.WriteString(f.text.w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// note は保留中のクラスタを出力してから書き出す。ファイルの区切りでクラスタの状態を初期化する
+func (f *graphemeFormatter) note(msg string) error {
+	if err := f.flush(); err != nil {
+		return err
+	}
+	f.breaker = graphemeBreaker{}
+	return f.text.note(msg)
+}
+
+func (f *graphemeFormatter) end() error {
+	return f.flush()
+}
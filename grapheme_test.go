@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestGraphemeBreaker(t *testing.T) {
+
+	cases := []struct {
+		input    []rune
+		expected []bool
+	}{
+		// 結合文字は直前の文字と同じクラスタになることを確認する(GB9)
+		{[]rune{'e', 0x301, 'a'}, []bool{true, false, true}},
+		// CRLF は1つのクラスタになることを確認する(GB3, GB4, GB5)
+		{[]rune{'a', '\r', '\n', 'b'}, []bool{true, true, false, true}},
+		// ZWJ で結合された絵文字が1つのクラスタになることを確認する(GB11)
+		{[]rune{0x1f468, 0x200d, 0x1f469, 0x200d, 0x1f467}, []bool{true, false, false, false, false}},
+		// 絵文字以外の後の ZWJ では結合されないことを確認する
+		{[]rune{'a', 0x200d, 0x1f469}, []bool{true, false, true}},
+		// Regional Indicator は2つずつ組になることを確認する(GB12, GB13)
+		{[]rune{0x1f1ef, 0x1f1f5, 0x1f1fa, 0x1f1f8, 0x1f1ef}, []bool{true, false, true, false, true}},
+		// ハングルの字母が音節として結合されることを確認する(GB6, GB7, GB8)
+		{[]rune{0x1100, 0x1161, 0x11a8, 0xac00, 0x11a8, 0xac01, 0x1161}, []bool{true, false, false, true, false, true, true}},
+		// SpacingMark と Prepend が結合されることを確認する(GB9a, GB9b)
+		{[]rune{0x915, 0x93f, 0x600, '1'}, []bool{true, false, true, false}},
+	}
+
+	for i, c := range cases {
+		breaker := graphemeBreaker{}
+		actual := []bool{}
+		for _, r := range c.input {
+			actual = append(actual, breaker.isBoundary(NewToken(r, TypeOk, nil)))
+		}
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("[%d] expected: %v, actual %v", i, c.expected, actual)
+		}
+	}
+
+	// 不正なバイト列は単独のクラスタになることを確認する
+	breaker := graphemeBreaker{}
+	breaker.isBoundary(NewToken('e', TypeOk, nil))
+	if !breaker.isBoundary(NewToken(0, TypeInvalidByteSequence, []byte{0xff})) ||
+		!breaker.isBoundary(NewToken(0x301, TypeOk, nil)) {
+		t.Errorf("expected: invalid byte sequence to be a separate cluster")
+	}
+
+}
+
+func TestGraphemeFormatter(t *testing.T) {
+
+	tokens := []*Token{
+		withPosition(NewToken('e', TypeOk, []byte{0x65}), 0, 0, 1, 1),
+		withPosition(NewToken(0x301, TypeOk, []byte{0xcc, 0x81}), 1, 1, 1, 2),
+		withPosition(NewToken('\n', TypeOk, []byte{0x0a}), 3, 3, 1, 3),
+	}
+
+	cases := []struct {
+		opts     options
+		expected string
+	}{
+		// クラスタの行に続けて、構成する Token が字下げして出力されることを確認する
+		{
+			options{graphemes: true},
+			"00000000\te\u0301\tcode points: 2, bytes: 3\n" +
+				"\t00000000\te\tU+0065\t65\tLATIN SMALL LETTER E\n" +
+				"\t00000001\t\u0301\tU+0301\tcc 81\tCOMBINING ACUTE ACCENT\n" +
+				"00000003\tLF\tcode points: 1, bytes: 1\n" +
+				"\t00000003\tLF\tU+000A\t0a\t<control> LINE FEED\n",
+		},
+		// -normalization ではクラスタ全体と各 Token の正規化結果が出力されることを確認する
+		{
+			options{graphemes: true, normalization: true},
+			"00000000\te\u0301\tcode points: 2, bytes: 3\tNFC: U+00E9; NFD: stable; NFKC: U+00E9; NFKD: stable\n" +
+				"\t00000000\te\tU+0065\t65\tLATIN SMALL LETTER E\tNFC: stable; NFD: stable; NFKC: stable; NFKD: stable\n" +
+				"\t00000001\t\u0301\tU+0301\tcc 81\tCOMBINING ACUTE ACCENT\tNFC: stable; NFD: stable; NFKC: stable; NFKD: stable\n" +
+				"00000003\tLF\tcode points: 1, bytes: 1\tNFC: stable; NFD: stable; NFKC: stable; NFKD: stable\n" +
+				"\t00000003\tLF\tU+000A\t0a\t<control> LINE FEED\tNFC: stable; NFD: stable; NFKC: stable; NFKD: stable\n",
+		},
+	}
+
+	for i, c := range cases {
+		buf := bytes.Buffer{}
+		f := newFormatter("text", &buf, &bytes.Buffer{}, c.opts)
+		f.begin()
+		for _, token := range tokens {
+			f.write("-", token)
+		}
+		f.end()
+
+		if buf.String() != c.expected {
+			t.Errorf("[%d] expected: %q, actual %q", i, c.expected, buf.String())
+		}
+	}
+
+	// テキスト以外の形式と -errors-only では -graphemes を指定できないことを確認する
+	for _, format := range []string{"table", "hexdump", "json", "jsonl", "csv"} {
+		if f := newFormatter(format, &bytes.Buffer{}, &bytes.Buffer{}, options{graphemes: true}); f != nil {
+			t.Errorf("expected: nil formatter for %s with -graphemes", format)
+		}
+	}
+	if f := newFormatter("text", &bytes.Buffer{}, &bytes.Buffer{}, options{graphemes: true, errorsOnly: true}); f != nil {
+		t.Errorf("expected: nil formatter for -errors-only with -graphemes")
+	}
+
+	// 指定できない理由がエラーメッセージで示されることを確認する
+	if err := checkGraphemes("json", options{graphemes: true}); err == nil || err.Error() != "-graphemes requires -format text" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := checkGraphemes("text", options{graphemes: true, errorsOnly: true}); err == nil || err.Error() != "-graphemes cannot be used with -errors-only" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := checkGraphemes("text", options{graphemes: true}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+}
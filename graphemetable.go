@@ -0,0 +1,665 @@
+package main
+
+const (
+	gcbOther = iota
+	gcbCR
+	gcbLF
+	gcbControl
+	gcbExtend
+	gcbZWJ
+	gcbRegionalIndicator
+	gcbPrepend
+	gcbSpacingMark
+	gcbL
+	gcbV
+	gcbT
+	gcbLV
+	gcbLVT
+	gcbExtendedPictographic
+)
+
+type graphemeBreakRange struct {
+	first    rune
+	last     rune
+	property int
+}
+
+// GraphemeBreakProperty-14.0.0.txt と emoji-data.txt の Extended_Pictographic より生成
+// LV と LVT はハングル音節の符号位置から計算するため含めない
+var graphemeBreakRanges = []graphemeBreakRange{
+	{0x0000, 0x0009, gcbControl},
+	{0x000A, 0x000A, gcbLF},
+	{0x000B, 0x000C, gcbControl},
+	{0x000D, 0x000D, gcbCR},
+	{0x000E, 0x001F, gcbControl},
+	{0x007F, 0x009F, gcbControl},
+	{0x00A9, 0x00A9, gcbExtendedPictographic},
+	{0x00AD, 0x00AD, gcbControl},
+	{0x00AE, 0x00AE, gcbExtendedPictographic},
+	{0x0300, 0x036F, gcbExtend},
+	{0x0483, 0x0489, gcbExtend},
+	{0x0591, 0x05BD, gcbExtend},
+	{0x05BF, 0x05BF, gcbExtend},
+	{0x05C1, 0x05C2, gcbExtend},
+	{0x05C4, 0x05C5, gcbExtend},
+	{0x05C7, 0x05C7, gcbExtend},
+	{0x0600, 0x0605, gcbPrepend},
+	{0x0610, 0x061A, gcbExtend},
+	{0x061C, 0x061C, gcbControl},
+	{0x064B, 0x065F, gcbExtend},
+	{0x0670, 0x0670, gcbExtend},
+	{0x06D6, 0x06DC, gcbExtend},
+	{0x06DD, 0x06DD, gcbPrepend},
+	{0x06DF, 0x06E4, gcbExtend},
+	{0x06E7, 0x06E8, gcbExtend},
+	{0x06EA, 0x06ED, gcbExtend},
+	{0x070F, 0x070F, gcbPrepend},
+	{0x0711, 0x0711, gcbExtend},
+	{0x0730, 0x074A, gcbExtend},
+	{0x07A6, 0x07B0, gcbExtend},
+	{0x07EB, 0x07F3, gcbExtend},
+	{0x07FD, 0x07FD, gcbExtend},
+	{0x0816, 0x0819, gcbExtend},
+	{0x081B, 0x0823, gcbExtend},
+	{0x0825, 0x0827, gcbExtend},
+	{0x0829, 0x082D, gcbExtend},
+	{0x0859, 0x085B, gcbExtend},
+	{0x0890, 0x0891, gcbPrepend},
+	{0x0898, 0x089F, gcbExtend},
+	{0x08CA, 0x08E1, gcbExtend},
+	{0x08E2, 0x08E2, gcbPrepend},
+	{0x08E3, 0x0902, gcbExtend},
+	{0x0903, 0x0903, gcbSpacingMark},
+	{0x093A, 0x093A, gcbExtend},
+	{0x093B, 0x093B, gcbSpacingMark},
+	{0x093C, 0x093C, gcbExtend},
+	{0x093E, 0x0940, gcbSpacingMark},
+	{0x0941, 0x0948, gcbExtend},
+	{0x0949, 0x094C, gcbSpacingMark},
+	{0x094D, 0x094D, gcbExtend},
+	{0x094E, 0x094F, gcbSpacingMark},
+	{0x0951, 0x0957, gcbExtend},
+	{0x0962, 0x0963, gcbExtend},
+	{0x0981, 0x0981, gcbExtend},
+	{0x0982, 0x0983, gcbSpacingMark},
+	{0x09BC, 0x09BC, gcbExtend},
+	{0x09BE, 0x09BE, gcbExtend},
+	{0x09BF, 0x09C0, gcbSpacingMark},
+	{0x09C1, 0x09C4, gcbExtend},
+	{0x09C7, 0x09C8, gcbSpacingMark},
+	{0x09CB, 0x09CC, gcbSpacingMark},
+	{0x09CD, 0x09CD, gcbExtend},
+	{0x09D7, 0x09D7, gcbExtend},
+	{0x09E2, 0x09E3, gcbExtend},
+	{0x09FE, 0x09FE, gcbExtend},
+	{0x0A01, 0x0A02, gcbExtend},
+	{0x0A03, 0x0A03, gcbSpacingMark},
+	{0x0A3C, 0x0A3C, gcbExtend},
+	{0x0A3E, 0x0A40, gcbSpacingMark},
+	{0x0A41, 0x0A42, gcbExtend},
+	{0x0A47, 0x0A48, gcbExtend},
+	{0x0A4B, 0x0A4D, gcbExtend},
+	{0x0A51, 0x0A51, gcbExtend},
+	{0x0A70, 0x0A71, gcbExtend},
+	{0x0A75, 0x0A75, gcbExtend},
+	{0x0A81, 0x0A82, gcbExtend},
+	{0x0A83, 0x0A83, gcbSpacingMark},
+	{0x0ABC, 0x0ABC, gcbExtend},
+	{0x0ABE, 0x0AC0, gcbSpacingMark},
+	{0x0AC1, 0x0AC5, gcbExtend},
+	{0x0AC7, 0x0AC8, gcbExtend},
+	{0x0AC9, 0x0AC9, gcbSpacingMark},
+	{0x0ACB, 0x0ACC, gcbSpacingMark},
+	{0x0ACD, 0x0ACD, gcbExtend},
+	{0x0AE2, 0x0AE3, gcbExtend},
+	{0x0AFA, 0x0AFF, gcbExtend},
+	{0x0B01, 0x0B01, gcbExtend},
+	{0x0B02, 0x0B03, gcbSpacingMark},
+	{0x0B3C, 0x0B3C, gcbExtend},
+	{0x0B3E, 0x0B3F, gcbExtend},
+	{0x0B40, 0x0B40, gcbSpacingMark},
+	{0x0B41, 0x0B44, gcbExtend},
+	{0x0B47, 0x0B48, gcbSpacingMark},
+	{0x0B4B, 0x0B4C, gcbSpacingMark},
+	{0x0B4D, 0x0B4D, gcbExtend},
+	{0x0B55, 0x0B57, gcbExtend},
+	{0x0B62, 0x0B63, gcbExtend},
+	{0x0B82, 0x0B82, gcbExtend},
+	{0x0BBE, 0x0BBE, gcbExtend},
+	{0x0BBF, 0x0BBF, gcbSpacingMark},
+	{0x0BC0, 0x0BC0, gcbExtend},
+	{0x0BC1, 0x0BC2, gcbSpacingMark},
+	{0x0BC6, 0x0BC8, gcbSpacingMark},
+	{0x0BCA, 0x0BCC, gcbSpacingMark},
+	{0x0BCD, 0x0BCD, gcbExtend},
+	{0x0BD7, 0x0BD7, gcbExtend},
+	{0x0C00, 0x0C00, gcbExtend},
+	{0x0C01, 0x0C03, gcbSpacingMark},
+	{0x0C04, 0x0C04, gcbExtend},
+	{0x0C3C, 0x0C3C, gcbExtend},
+	{0x0C3E, 0x0C40, gcbExtend},
+	{0x0C41, 0x0C44, gcbSpacingMark},
+	{0x0C46, 0x0C48, gcbExtend},
+	{0x0C4A, 0x0C4D, gcbExtend},
+	{0x0C55, 0x0C56, gcbExtend},
+	{0x0C62, 0x0C63, gcbExtend},
+	{0x0C81, 0x0C81, gcbExtend},
+	{0x0C82, 0x0C83, gcbSpacingMark},
+	{0x0CBC, 0x0CBC, gcbExtend},
+	{0x0CBE, 0x0CBE, gcbSpacingMark},
+	{0x0CBF, 0x0CBF, gcbExtend},
+	{0x0CC0, 0x0CC1, gcbSpacingMark},
+	{0x0CC2, 0x0CC2, gcbExtend},
+	{0x0CC3, 0x0CC4, gcbSpacingMark},
+	{0x0CC6, 0x0CC6, gcbExtend},
+	{0x0CC7, 0x0CC8, gcbSpacingMark},
+	{0x0CCA, 0x0CCB, gcbSpacingMark},
+	{0x0CCC, 0x0CCD, gcbExtend},
+	{0x0CD5, 0x0CD6, gcbExtend},
+	{0x0CE2, 0x0CE3, gcbExtend},
+	{0x0D00, 0x0D01, gcbExtend},
+	{0x0D02, 0x0D03, gcbSpacingMark},
+	{0x0D3B, 0x0D3C, gcbExtend},
+	{0x0D3E, 0x0D3E, gcbExtend},
+	{0x0D3F, 0x0D40, gcbSpacingMark},
+	{0x0D41, 0x0D44, gcbExtend},
+	{0x0D46, 0x0D48, gcbSpacingMark},
+	{0x0D4A, 0x0D4C, gcbSpacingMark},
+	{0x0D4D, 0x0D4D, gcbExtend},
+	{0x0D4E, 0x0D4E, gcbPrepend},
+	{0x0D57, 0x0D57, gcbExtend},
+	{0x0D62, 0x0D63, gcbExtend},
+	{0x0D81, 0x0D81, gcbExtend},
+	{0x0D82, 0x0D83, gcbSpacingMark},
+	{0x0DCA, 0x0DCA, gcbExtend},
+	{0x0DCF, 0x0DCF, gcbExtend},
+	{0x0DD0, 0x0DD1, gcbSpacingMark},
+	{0x0DD2, 0x0DD4, gcbExtend},
+	{0x0DD6, 0x0DD6, gcbExtend},
+	{0x0DD8, 0x0DDE, gcbSpacingMark},
+	{0x0DDF, 0x0DDF, gcbExtend},
+	{0x0DF2, 0x0DF3, gcbSpacingMark},
+	{0x0E31, 0x0E31, gcbExtend},
+	{0x0E33, 0x0E33, gcbSpacingMark},
+	{0x0E34, 0x0E3A, gcbExtend},
+	{0x0E47, 0x0E4E, gcbExtend},
+	{0x0EB1, 0x0EB1, gcbExtend},
+	{0x0EB3, 0x0EB3, gcbSpacingMark},
+	{0x0EB4, 0x0EBC, gcbExtend},
+	{0x0EC8, 0x0ECD, gcbExtend},
+	{0x0F18, 0x0F19, gcbExtend},
+	{0x0F35, 0x0F35, gcbExtend},
+	{0x0F37, 0x0F37, gcbExtend},
+	{0x0F39, 0x0F39, gcbExtend},
+	{0x0F3E, 0x0F3F, gcbSpacingMark},
+	{0x0F71, 0x0F7E, gcbExtend},
+	{0x0F7F, 0x0F7F, gcbSpacingMark},
+	{0x0F80, 0x0F84, gcbExtend},
+	{0x0F86, 0x0F87, gcbExtend},
+	{0x0F8D, 0x0F97, gcbExtend},
+	{0x0F99, 0x0FBC, gcbExtend},
+	{0x0FC6, 0x0FC6, gcbExtend},
+	{0x102D, 0x1030, gcbExtend},
+	{0x1031, 0x1031, gcbSpacingMark},
+	{0x1032, 0x1037, gcbExtend},
+	{0x1039, 0x103A, gcbExtend},
+	{0x103B, 0x103C, gcbSpacingMark},
+	{0x103D, 0x103E, gcbExtend},
+	{0x1056, 0x1057, gcbSpacingMark},
+	{0x1058, 0x1059, gcbExtend},
+	{0x105E, 0x1060, gcbExtend},
+	{0x1071, 0x1074, gcbExtend},
+	{0x1082, 0x1082, gcbExtend},
+	{0x1084, 0x1084, gcbSpacingMark},
+	{0x1085, 0x1086, gcbExtend},
+	{0x108D, 0x108D, gcbExtend},
+	{0x109D, 0x109D, gcbExtend},
+	{0x1100, 0x115F, gcbL},
+	{0x1160, 0x11A7, gcbV},
+	{0x11A8, 0x11FF, gcbT},
+	{0x135D, 0x135F, gcbExtend},
+	{0x1712, 0x1714, gcbExtend},
+	{0x1715, 0x1715, gcbSpacingMark},
+	{0x1732, 0x1733, gcbExtend},
+	{0x1734, 0x1734, gcbSpacingMark},
+	{0x1752, 0x1753, gcbExtend},
+	{0x1772, 0x1773, gcbExtend},
+	{0x17B4, 0x17B5, gcbExtend},
+	{0x17B6, 0x17B6, gcbSpacingMark},
+	{0x17B7, 0x17BD, gcbExtend},
+	{0x17BE, 0x17C5, gcbSpacingMark},
+	{0x17C6, 0x17C6, gcbExtend},
+	{0x17C7, 0x17C8, gcbSpacingMark},
+	{0x17C9, 0x17D3, gcbExtend},
+	{0x17DD, 0x17DD, gcbExtend},
+	{0x180B, 0x180D, gcbExtend},
+	{0x180E, 0x180E, gcbControl},
+	{0x180F, 0x180F, gcbExtend},
+	{0x1885, 0x1886, gcbExtend},
+	{0x18A9, 0x18A9, gcbExtend},
+	{0x1920, 0x1922, gcbExtend},
+	{0x1923, 0x1926, gcbSpacingMark},
+	{0x1927, 0x1928, gcbExtend},
+	{0x1929, 0x192B, gcbSpacingMark},
+	{0x1930, 0x1931, gcbSpacingMark},
+	{0x1932, 0x1932, gcbExtend},
+	{0x1933, 0x1938, gcbSpacingMark},
+	{0x1939, 0x193B, gcbExtend},
+	{0x1A17, 0x1A18, gcbExtend},
+	{0x1A19, 0x1A1A, gcbSpacingMark},
+	{0x1A1B, 0x1A1B, gcbExtend},
+	{0x1A55, 0x1A55, gcbSpacingMark},
+	{0x1A56, 0x1A56, gcbExtend},
+	{0x1A57, 0x1A57, gcbSpacingMark},
+	{0x1A58, 0x1A5E, gcbExtend},
+	{0x1A60, 0x1A60, gcbExtend},
+	{0x1A62, 0x1A62, gcbExtend},
+	{0x1A65, 0x1A6C, gcbExtend},
+	{0x1A6D, 0x1A72, gcbSpacingMark},
+	{0x1A73, 0x1A7C, gcbExtend},
+	{0x1A7F, 0x1A7F, gcbExtend},
+	{0x1AB0, 0x1ACE, gcbExtend},
+	{0x1B00, 0x1B03, gcbExtend},
+	{0x1B04, 0x1B04, gcbSpacingMark},
+	{0x1B34, 0x1B3A, gcbExtend},
+	{0x1B3B, 0x1B3B, gcbSpacingMark},
+	{0x1B3C, 0x1B3C, gcbExtend},
+	{0x1B3D, 0x1B41, gcbSpacingMark},
+	{0x1B42, 0x1B42, gcbExtend},
+	{0x1B43, 0x1B44, gcbSpacingMark},
+	{0x1B6B, 0x1B73, gcbExtend},
+	{0x1B80, 0x1B81, gcbExtend},
+	{0x1B82, 0x1B82, gcbSpacingMark},
+	{0x1BA1, 0x1BA1, gcbSpacingMark},
+	{0x1BA2, 0x1BA5, gcbExtend},
+	{0x1BA6, 0x1BA7, gcbSpacingMark},
+	{0x1BA8, 0x1BA9, gcbExtend},
+	{0x1BAA, 0x1BAA, gcbSpacingMark},
+	{0x1BAB, 0x1BAD, gcbExtend},
+	{0x1BE6, 0x1BE6, gcbExtend},
+	{0x1BE7, 0x1BE7, gcbSpacingMark},
+	{0x1BE8, 0x1BE9, gcbExtend},
+	{0x1BEA, 0x1BEC, gcbSpacingMark},
+	{0x1BED, 0x1BED, gcbExtend},
+	{0x1BEE, 0x1BEE, gcbSpacingMark},
+	{0x1BEF, 0x1BF1, gcbExtend},
+	{0x1BF2, 0x1BF3, gcbSpacingMark},
+	{0x1C24, 0x1C2B, gcbSpacingMark},
+	{0x1C2C, 0x1C33, gcbExtend},
+	{0x1C34, 0x1C35, gcbSpacingMark},
+	{0x1C36, 0x1C37, gcbExtend},
+	{0x1CD0, 0x1CD2, gcbExtend},
+	{0x1CD4, 0x1CE0, gcbExtend},
+	{0x1CE1, 0x1CE1, gcbSpacingMark},
+	{0x1CE2, 0x1CE8, gcbExtend},
+	{0x1CED, 0x1CED, gcbExtend},
+	{0x1CF4, 0x1CF4, gcbExtend},
+	{0x1CF7, 0x1CF7, gcbSpacingMark},
+	{0x1CF8, 0x1CF9, gcbExtend},
+	{0x1DC0, 0x1DFF, gcbExtend},
+	{0x200B, 0x200B, gcbControl},
+	{0x200C, 0x200C, gcbExtend},
+	{0x200D, 0x200D, gcbZWJ},
+	{0x200E, 0x200F, gcbControl},
+	{0x2028, 0x202E, gcbControl},
+	{0x203C, 0x203C, gcbExtendedPictographic},
+	{0x2049, 0x2049, gcbExtendedPictographic},
+	{0x2060, 0x206F, gcbControl},
+	{0x20D0, 0x20F0, gcbExtend},
+	{0x2122, 0x2122, gcbExtendedPictographic},
+	{0x2139, 0x2139, gcbExtendedPictographic},
+	{0x2194, 0x2199, gcbExtendedPictographic},
+	{0x21A9, 0x21AA, gcbExtendedPictographic},
+	{0x231A, 0x231B, gcbExtendedPictographic},
+	{0x2328, 0x2328, gcbExtendedPictographic},
+	{0x2388, 0x2388, gcbExtendedPictographic},
+	{0x23CF, 0x23CF, gcbExtendedPictographic},
+	{0x23E9, 0x23F3, gcbExtendedPictographic},
+	{0x23F8, 0x23FA, gcbExtendedPictographic},
+	{0x24C2, 0x24C2, gcbExtendedPictographic},
+	{0x25AA, 0x25AB, gcbExtendedPictographic},
+	{0x25B6, 0x25B6, gcbExtendedPictographic},
+	{0x25C0, 0x25C0, gcbExtendedPictographic},
+	{0x25FB, 0x25FE, gcbExtendedPictographic},
+	{0x2600, 0x2605, gcbExtendedPictographic},
+	{0x2607, 0x2612, gcbExtendedPictographic},
+	{0x2614, 0x2685, gcbExtendedPictographic},
+	{0x2690, 0x2705, gcbExtendedPictographic},
+	{0x2708, 0x2712, gcbExtendedPictographic},
+	{0x2714, 0x2714, gcbExtendedPictographic},
+	{0x2716, 0x2716, gcbExtendedPictographic},
+	{0x271D, 0x271D, gcbExtendedPictographic},
+	{0x2721, 0x2721, gcbExtendedPictographic},
+	{0x2728, 0x2728, gcbExtendedPictographic},
+	{0x2733, 0x2734, gcbExtendedPictographic},
+	{0x2744, 0x2744, gcbExtendedPictographic},
+	{0x2747, 0x2747, gcbExtendedPictographic},
+	{0x274C, 0x274C, gcbExtendedPictographic},
+	{0x274E, 0x274E, gcbExtendedPictographic},
+	{0x2753, 0x2755, gcbExtendedPictographic},
+	{0x2757, 0x2757, gcbExtendedPictographic},
+	{0x2763, 0x2767, gcbExtendedPictographic},
+	{0x2795, 0x2797, gcbExtendedPictographic},
+	{0x27A1, 0x27A1, gcbExtendedPictographic},
+	{0x27B0, 0x27B0, gcbExtendedPictographic},
+	{0x27BF, 0x27BF, gcbExtendedPictographic},
+	{0x2934, 0x2935, gcbExtendedPictographic},
+	{0x2B05, 0x2B07, gcbExtendedPictographic},
+	{0x2B1B, 0x2B1C, gcbExtendedPictographic},
+	{0x2B50, 0x2B50, gcbExtendedPictographic},
+	{0x2B55, 0x2B55, gcbExtendedPictographic},
+	{0x2CEF, 0x2CF1, gcbExtend},
+	{0x2D7F, 0x2D7F, gcbExtend},
+	{0x2DE0, 0x2DFF, gcbExtend},
+	{0x302A, 0x302F, gcbExtend},
+	{0x3030, 0x3030, gcbExtendedPictographic},
+	{0x303D, 0x303D, gcbExtendedPictographic},
+	{0x3099, 0x309A, gcbExtend},
+	{0x3297, 0x3297, gcbExtendedPictographic},
+	{0x3299, 0x3299, gcbExtendedPictographic},
+	{0xA66F, 0xA672, gcbExtend},
+	{0xA674, 0xA67D, gcbExtend},
+	{0xA69E, 0xA69F, gcbExtend},
+	{0xA6F0, 0xA6F1, gcbExtend},
+	{0xA802, 0xA802, gcbExtend},
+	{0xA806, 0xA806, gcbExtend},
+	{0xA80B, 0xA80B, gcbExtend},
+	{0xA823, 0xA824, gcbSpacingMark},
+	{0xA825, 0xA826, gcbExtend},
+	{0xA827, 0xA827, gcbSpacingMark},
+	{0xA82C, 0xA82C, gcbExtend},
+	{0xA880, 0xA881, gcbSpacingMark},
+	{0xA8B4, 0xA8C3, gcbSpacingMark},
+	{0xA8C4, 0xA8C5, gcbExtend},
+	{0xA8E0, 0xA8F1, gcbExtend},
+	{0xA8FF, 0xA8FF, gcbExtend},
+	{0xA926, 0xA92D, gcbExtend},
+	{0xA947, 0xA951, gcbExtend},
+	{0xA952, 0xA953, gcbSpacingMark},
+	{0xA960, 0xA97C, gcbL},
+	{0xA980, 0xA982, gcbExtend},
+	{0xA983, 0xA983, gcbSpacingMark},
+	{0xA9B3, 0xA9B3, gcbExtend},
+	{0xA9B4, 0xA9B5, gcbSpacingMark},
+	{0xA9B6, 0xA9B9, gcbExtend},
+	{0xA9BA, 0xA9BB, gcbSpacingMark},
+	{0xA9BC, 0xA9BD, gcbExtend},
+	{0xA9BE, 0xA9C0, gcbSpacingMark},
+	{0xA9E5, 0xA9E5, gcbExtend},
+	{0xAA29, 0xAA2E, gcbExtend},
+	{0xAA2F, 0xAA30, gcbSpacingMark},
+	{0xAA31, 0xAA32, gcbExtend},
+	{0xAA33, 0xAA34, gcbSpacingMark},
+	{0xAA35, 0xAA36, gcbExtend},
+	{0xAA43, 0xAA43, gcbExtend},
+	{0xAA4C, 0xAA4C, gcbExtend},
+	{0xAA4D, 0xAA4D, gcbSpacingMark},
+	{0xAA7C, 0xAA7C, gcbExtend},
+	{0xAAB0, 0xAAB0, gcbExtend},
+	{0xAAB2, 0xAAB4, gcbExtend},
+	{0xAAB7, 0xAAB8, gcbExtend},
+	{0xAABE, 0xAABF, gcbExtend},
+	{0xAAC1, 0xAAC1, gcbExtend},
+	{0xAAEB, 0xAAEB, gcbSpacingMark},
+	{0xAAEC, 0xAAED, gcbExtend},
+	{0xAAEE, 0xAAEF, gcbSpacingMark},
+	{0xAAF5, 0xAAF5, gcbSpacingMark},
+	{0xAAF6, 0xAAF6, gcbExtend},
+	{0xABE3, 0xABE4, gcbSpacingMark},
+	{0xABE5, 0xABE5, gcbExtend},
+	{0xABE6, 0xABE7, gcbSpacingMark},
+	{0xABE8, 0xABE8, gcbExtend},
+	{0xABE9, 0xABEA, gcbSpacingMark},
+	{0xABEC, 0xABEC, gcbSpacingMark},
+	{0xABED, 0xABED, gcbExtend},
+	{0xD7B0, 0xD7C6, gcbV},
+	{0xD7CB, 0xD7FB, gcbT},
+	{0xFB1E, 0xFB1E, gcbExtend},
+	{0xFE00, 0xFE0F, gcbExtend},
+	{0xFE20, 0xFE2F, gcbExtend},
+	{0xFEFF, 0xFEFF, gcbControl},
+	{0xFF9E, 0xFF9F, gcbExtend},
+	{0xFFF0, 0xFFFB, gcbControl},
+	{0x101FD, 0x101FD, gcbExtend},
+	{0x102E0, 0x102E0, gcbExtend},
+	{0x10376, 0x1037A, gcbExtend},
+	{0x10A01, 0x10A03, gcbExtend},
+	{0x10A05, 0x10A06, gcbExtend},
+	{0x10A0C, 0x10A0F, gcbExtend},
+	{0x10A38, 0x10A3A, gcbExtend},
+	{0x10A3F, 0x10A3F, gcbExtend},
+	{0x10AE5, 0x10AE6, gcbExtend},
+	{0x10D24, 0x10D27, gcbExtend},
+	{0x10EAB, 0x10EAC, gcbExtend},
+	{0x10F46, 0x10F50, gcbExtend},
+	{0x10F82, 0x10F85, gcbExtend},
+	{0x11000, 0x11000, gcbSpacingMark},
+	{0x11001, 0x11001, gcbExtend},
+	{0x11002, 0x11002, gcbSpacingMark},
+	{0x11038, 0x11046, gcbExtend},
+	{0x11070, 0x11070, gcbExtend},
+	{0x11073, 0x11074, gcbExtend},
+	{0x1107F, 0x11081, gcbExtend},
+	{0x11082, 0x11082, gcbSpacingMark},
+	{0x110B0, 0x110B2, gcbSpacingMark},
+	{0x110B3, 0x110B6, gcbExtend},
+	{0x110B7, 0x110B8, gcbSpacingMark},
+	{0x110B9, 0x110BA, gcbExtend},
+	{0x110BD, 0x110BD, gcbPrepend},
+	{0x110C2, 0x110C2, gcbExtend},
+	{0x110CD, 0x110CD, gcbPrepend},
+	{0x11100, 0x11102, gcbExtend},
+	{0x11127, 0x1112B, gcbExtend},
+	{0x1112C, 0x1112C, gcbSpacingMark},
+	{0x1112D, 0x11134, gcbExtend},
+	{0x11145, 0x11146, gcbSpacingMark},
+	{0x11173, 0x11173, gcbExtend},
+	{0x11180, 0x11181, gcbExtend},
+	{0x11182, 0x11182, gcbSpacingMark},
+	{0x111B3, 0x111B5, gcbSpacingMark},
+	{0x111B6, 0x111BE, gcbExtend},
+	{0x111BF, 0x111C0, gcbSpacingMark},
+	{0x111C2, 0x111C3, gcbPrepend},
+	{0x111C9, 0x111CC, gcbExtend},
+	{0x111CE, 0x111CE, gcbSpacingMark},
+	{0x111CF, 0x111CF, gcbExtend},
+	{0x1122C, 0x1122E, gcbSpacingMark},
+	{0x1122F, 0x11231, gcbExtend},
+	{0x11232, 0x11233, gcbSpacingMark},
+	{0x11234, 0x11234, gcbExtend},
+	{0x11235, 0x11235, gcbSpacingMark},
+	{0x11236, 0x11237, gcbExtend},
+	{0x1123E, 0x1123E, gcbExtend},
+	{0x112DF, 0x112DF, gcbExtend},
+	{0x112E0, 0x112E2, gcbSpacingMark},
+	{0x112E3, 0x112EA, gcbExtend},
+	{0x11300, 0x11301, gcbExtend},
+	{0x11302, 0x11303, gcbSpacingMark},
+	{0x1133B, 0x1133C, gcbExtend},
+	{0x1133E, 0x1133E, gcbExtend},
+	{0x1133F, 0x1133F, gcbSpacingMark},
+	{0x11340, 0x11340, gcbExtend},
+	{0x11341, 0x11344, gcbSpacingMark},
+	{0x11347, 0x11348, gcbSpacingMark},
+	{0x1134B, 0x1134D, gcbSpacingMark},
+	{0x11357, 0x11357, gcbExtend},
+	{0x11362, 0x11363, gcbSpacingMark},
+	{0x11366, 0x1136C, gcbExtend},
+	{0x11370, 0x11374, gcbExtend},
+	{0x11435, 0x11437, gcbSpacingMark},
+	{0x11438, 0x1143F, gcbExtend},
+	{0x11440, 0x11441, gcbSpacingMark},
+	{0x11442, 0x11444, gcbExtend},
+	{0x11445, 0x11445, gcbSpacingMark},
+	{0x11446, 0x11446, gcbExtend},
+	{0x1145E, 0x1145E, gcbExtend},
+	{0x114B0, 0x114B0, gcbExtend},
+	{0x114B1, 0x114B2, gcbSpacingMark},
+	{0x114B3, 0x114B8, gcbExtend},
+	{0x114B9, 0x114B9, gcbSpacingMark},
+	{0x114BA, 0x114BA, gcbExtend},
+	{0x114BB, 0x114BC, gcbSpacingMark},
+	{0x114BD, 0x114BD, gcbExtend},
+	{0x114BE, 0x114BE, gcbSpacingMark},
+	{0x114BF, 0x114C0, gcbExtend},
+	{0x114C1, 0x114C1, gcbSpacingMark},
+	{0x114C2, 0x114C3, gcbExtend},
+	{0x115AF, 0x115AF, gcbExtend},
+	{0x115B0, 0x115B1, gcbSpacingMark},
+	{0x115B2, 0x115B5, gcbExtend},
+	{0x115B8, 0x115BB, gcbSpacingMark},
+	{0x115BC, 0x115BD, gcbExtend},
+	{0x115BE, 0x115BE, gcbSpacingMark},
+	{0x115BF, 0x115C0, gcbExtend},
+	{0x115DC, 0x115DD, gcbExtend},
+	{0x11630, 0x11632, gcbSpacingMark},
+	{0x11633, 0x1163A, gcbExtend},
+	{0x1163B, 0x1163C, gcbSpacingMark},
+	{0x1163D, 0x1163D, gcbExtend},
+	{0x1163E, 0x1163E, gcbSpacingMark},
+	{0x1163F, 0x11640, gcbExtend},
+	{0x116AB, 0x116AB, gcbExtend},
+	{0x116AC, 0x116AC, gcbSpacingMark},
+	{0x116AD, 0x116AD, gcbExtend},
+	{0x116AE, 0x116AF, gcbSpacingMark},
+	{0x116B0, 0x116B5, gcbExtend},
+	{0x116B6, 0x116B6, gcbSpacingMark},
+	{0x116B7, 0x116B7, gcbExtend},
+	{0x1171D, 0x1171F, gcbExtend},
+	{0x11722, 0x11725, gcbExtend},
+	{0x11726, 0x11726, gcbSpacingMark},
+	{0x11727, 0x1172B, gcbExtend},
+	{0x1182C, 0x1182E, gcbSpacingMark},
+	{0x1182F, 0x11837, gcbExtend},
+	{0x11838, 0x11838, gcbSpacingMark},
+	{0x11839, 0x1183A, gcbExtend},
+	{0x11930, 0x11930, gcbExtend},
+	{0x11931, 0x11935, gcbSpacingMark},
+	{0x11937, 0x11938, gcbSpacingMark},
+	{0x1193B, 0x1193C, gcbExtend},
+	{0x1193D, 0x1193D, gcbSpacingMark},
+	{0x1193E, 0x1193E, gcbExtend},
+	{0x1193F, 0x1193F, gcbPrepend},
+	{0x11940, 0x11940, gcbSpacingMark},
+	{0x11941, 0x11941, gcbPrepend},
+	{0x11942, 0x11942, gcbSpacingMark},
+	{0x11943, 0x11943, gcbExtend},
+	{0x119D1, 0x119D3, gcbSpacingMark},
+	{0x119D4, 0x119D7, gcbExtend},
+	{0x119DA, 0x119DB, gcbExtend},
+	{0x119DC, 0x119DF, gcbSpacingMark},
+	{0x119E0, 0x119E0, gcbExtend},
+	{0x119E4, 0x119E4, gcbSpacingMark},
+	{0x11A01, 0x11A0A, gcbExtend},
+	{0x11A33, 0x11A38, gcbExtend},
+	{0x11A39, 0x11A39, gcbSpacingMark},
+	{0x11A3A, 0x11A3A, gcbPrepend},
+	{0x11A3B, 0x11A3E, gcbExtend},
+	{0x11A47, 0x11A47, gcbExtend},
+	{0x11A51, 0x11A56, gcbExtend},
+	{0x11A57, 0x11A58, gcbSpacingMark},
+	{0x11A59, 0x11A5B, gcbExtend},
+	{0x11A84, 0x11A89, gcbPrepend},
+	{0x11A8A, 0x11A96, gcbExtend},
+	{0x11A97, 0x11A97, gcbSpacingMark},
+	{0x11A98, 0x11A99, gcbExtend},
+	{0x11C2F, 0x11C2F, gcbSpacingMark},
+	{0x11C30, 0x11C36, gcbExtend},
+	{0x11C38, 0x11C3D, gcbExtend},
+	{0x11C3E, 0x11C3E, gcbSpacingMark},
+	{0x11C3F, 0x11C3F, gcbExtend},
+	{0x11C92, 0x11CA7, gcbExtend},
+	{0x11CA9, 0x11CA9, gcbSpacingMark},
+	{0x11CAA, 0x11CB0, gcbExtend},
+	{0x11CB1, 0x11CB1, gcbSpacingMark},
+	{0x11CB2, 0x11CB3, gcbExtend},
+	{0x11CB4, 0x11CB4, gcbSpacingMark},
+	{0x11CB5, 0x11CB6, gcbExtend},
+	{0x11D31, 0x11D36, gcbExtend},
+	{0x11D3A, 0x11D3A, gcbExtend},
+	{0x11D3C, 0x11D3D, gcbExtend},
+	{0x11D3F, 0x11D45, gcbExtend},
+	{0x11D46, 0x11D46, gcbPrepend},
+	{0x11D47, 0x11D47, gcbExtend},
+	{0x11D8A, 0x11D8E, gcbSpacingMark},
+	{0x11D90, 0x11D91, gcbExtend},
+	{0x11D93, 0x11D94, gcbSpacingMark},
+	{0x11D95, 0x11D95, gcbExtend},
+	{0x11D96, 0x11D96, gcbSpacingMark},
+	{0x11D97, 0x11D97, gcbExtend},
+	{0x11EF3, 0x11EF4, gcbExtend},
+	{0x11EF5, 0x11EF6, gcbSpacingMark},
+	{0x13430, 0x13438, gcbControl},
+	{0x16AF0, 0x16AF4, gcbExtend},
+	{0x16B30, 0x16B36, gcbExtend},
+	{0x16F4F, 0x16F4F, gcbExtend},
+	{0x16F51, 0x16F87, gcbSpacingMark},
+	{0x16F8F, 0x16F92, gcbExtend},
+	{0x16FE4, 0x16FE4, gcbExtend},
+	{0x16FF0, 0x16FF1, gcbSpacingMark},
+	{0x1BC9D, 0x1BC9E, gcbExtend},
+	{0x1BCA0, 0x1BCA3, gcbControl},
+	{0x1CF00, 0x1CF2D, gcbExtend},
+	{0x1CF30, 0x1CF46, gcbExtend},
+	{0x1D165, 0x1D165, gcbExtend},
+	{0x1D166, 0x1D166, gcbSpacingMark},
+	{0x1D167, 0x1D169, gcbExtend},
+	{0x1D16D, 0x1D16D, gcbSpacingMark},
+	{0x1D16E, 0x1D172, gcbExtend},
+	{0x1D173, 0x1D17A, gcbControl},
+	{0x1D17B, 0x1D182, gcbExtend},
+	{0x1D185, 0x1D18B, gcbExtend},
+	{0x1D1AA, 0x1D1AD, gcbExtend},
+	{0x1D242, 0x1D244, gcbExtend},
+	{0x1DA00, 0x1DA36, gcbExtend},
+	{0x1DA3B, 0x1DA6C, gcbExtend},
+	{0x1DA75, 0x1DA75, gcbExtend},
+	{0x1DA84, 0x1DA84, gcbExtend},
+	{0x1DA9B, 0x1DA9F, gcbExtend},
+	{0x1DAA1, 0x1DAAF, gcbExtend},
+	{0x1E000, 0x1E006, gcbExtend},
+	{0x1E008, 0x1E018, gcbExtend},
+	{0x1E01B, 0x1E021, gcbExtend},
+	{0x1E023, 0x1E024, gcbExtend},
+	{0x1E026, 0x1E02A, gcbExtend},
+	{0x1E130, 0x1E136, gcbExtend},
+	{0x1E2AE, 0x1E2AE, gcbExtend},
+	{0x1E2EC, 0x1E2EF, gcbExtend},
+	{0x1E8D0, 0x1E8D6, gcbExtend},
+	{0x1E944, 0x1E94A, gcbExtend},
+	{0x1F000, 0x1F0FF, gcbExtendedPictographic},
+	{0x1F10D, 0x1F10F, gcbExtendedPictographic},
+	{0x1F12F, 0x1F12F, gcbExtendedPictographic},
+	{0x1F16C, 0x1F171, gcbExtendedPictographic},
+	{0x1F17E, 0x1F17F, gcbExtendedPictographic},
+	{0x1F18E, 0x1F18E, gcbExtendedPictographic},
+	{0x1F191, 0x1F19A, gcbExtendedPictographic},
+	{0x1F1AD, 0x1F1E5, gcbExtendedPictographic},
+	{0x1F1E6, 0x1F1FF, gcbRegionalIndicator},
+	{0x1F201, 0x1F20F, gcbExtendedPictographic},
+	{0x1F21A, 0x1F21A, gcbExtendedPictographic},
+	{0x1F22F, 0x1F22F, gcbExtendedPictographic},
+	{0x1F232, 0x1F23A, gcbExtendedPictographic},
+	{0x1F23C, 0x1F23F, gcbExtendedPictographic},
+	{0x1F249, 0x1F3FA, gcbExtendedPictographic},
+	{0x1F3FB, 0x1F3FF, gcbExtend},
+	{0x1F400, 0x1F53D, gcbExtendedPictographic},
+	{0x1F546, 0x1F64F, gcbExtendedPictographic},
+	{0x1F680, 0x1F6FF, gcbExtendedPictographic},
+	{0x1F774, 0x1F77F, gcbExtendedPictographic},
+	{0x1F7D5, 0x1F7FF, gcbExtendedPictographic},
+	{0x1F80C, 0x1F80F, gcbExtendedPictographic},
+	{0x1F848, 0x1F84F, gcbExtendedPictographic},
+	{0x1F85A, 0x1F85F, gcbExtendedPictographic},
+	{0x1F888, 0x1F88F, gcbExtendedPictographic},
+	{0x1F8AE, 0x1F8FF, gcbExtendedPictographic},
+	{0x1F90C, 0x1F93A, gcbExtendedPictographic},
+	{0x1F93C, 0x1F945, gcbExtendedPictographic},
+	{0x1F947, 0x1FAFF, gcbExtendedPictographic},
+	{0x1FC00, 0x1FFFD, gcbExtendedPictographic},
+	{0xE0000, 0xE001F, gcbControl},
+	{0xE0020, 0xE007F, gcbExtend},
+	{0xE0080, 0xE00FF, gcbControl},
+	{0xE0100, 0xE01EF, gcbExtend},
+	{0xE01F0, 0xE0FFF, gcbControl},
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// 1行に出力するバイト数
+const hexdumpWidth = 16
+
+// hexdumpByte は1バイトと、そのバイトを含む Token を表す
+type hexdumpByte struct {
+	value byte
+	token *Token
+	// Token の先頭のバイトかどうか
+	first bool
+	// Token の末尾のバイトかどうか
+	last bool
+}
+
+// multiByte はバイトを含む Token が複数バイトからなるかどうかを返す
+func (b hexdumpByte) multiByte() bool {
+	return len(b.token.Bytes) > 1
+}
+
+// hexdumpFormatter は xxd のように1行に16バイトずつ、オフセット、16進数、文字を並べて出力する
+// 複数バイトからなる Token は [ と ] で囲み、隣り合う Token の境界は | で表す
+type hexdumpFormatter struct {
+	w      io.Writer
+	opts   options
+	offset int64
+	row    []hexdumpByte
+}
+
+func (f *hexdumpFormatter) begin() error {
+	return nil
+}
+
+func (f *hexdumpFormatter) write(path string, token *Token) error {
+	// -errors-only などで Token が連続していないときは、オフセットがずれないよう新しい行を始める
+	if len(f.row) > 0 && token.Offset != f.offset+int64(len(f.row)) {
+		if err := f.flush(); err != nil {
+			return err
+		}
+	}
+
+	for i, b := range token.Bytes {
+		if len(f.row) == 0 {
+			f.offset = token.Offset + int64(i)
+		}
+		f.row = append(f.row, hexdumpByte{
+			value: b,
+			token: token,
+			first: i == 0,
+			last:  i == len(token.Bytes)-1,
+		})
+		if len(f.row) == hexdumpWidth {
+			if err := f.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// flush は途中までの行を出力する
+func (f *hexdumpFormatter) flush() error {
+	if len(f.row) == 0 {
+		return nil
+	}
+
+	var hex strings.Builder
+	for i, b := range f.row {
+		hex.WriteString(hexdumpSeparator(f.row, i))
+		hex.WriteString(f.colorize(fmt.Sprintf("%02x", b.value), b.token))
+	}
+	hex.WriteString(hexdumpSeparator(f.row, len(f.row)))
+	hex.WriteString(strings.Repeat("   ", hexdumpWidth-len(f.row)))
+
+	_, err := fmt.Fprintf(f.w, "%08x:%s %s\n", f.offset, hex.String(), f.text())
+	f.row = f.row[:0]
+	return err
+}
+
+// hexdumpSeparator は row の i 番目のバイトの直前に置く区切り文字を返す
+func hexdumpSeparator(row []hexdumpByte, i int) string {
+	closing := i > 0 && row[i-1].last && row[i-1].multiByte()
+	opening := i < len(row) && row[i].first && row[i].multiByte()
+	switch {
+	case closing && opening:
+		return "|"
+	case closing:
+		return "]"
+	case opening:
+		return "["
+	}
+	return " "
+}
+
+// text は各 Token の先頭のバイトの位置に文字を並べる。表示できない文字は . で表し、残りのバイトの位置は空白で埋める
+func (f *hexdumpFormatter) text() string {
+	var s strings.Builder
+	for i := 0; i < len(f.row); {
+		b := f.row[i]
+		if !b.first {
+			s.WriteString(" ")
+			i++
+			continue
+		}
+
+		n := len(b.token.Bytes)
+		if n > len(f.row)-i {
+			n = len(f.row) - i
+		}
+		char := hexdumpChar(b.token)
+		w := displayWidth(char)
+		if w > n {
+			char, w = ".", 1
+		}
+		s.WriteString(f.colorize(char, b.token))
+		s.WriteString(strings.Repeat(" ", n-w))
+		i += n
+	}
+	return s.String()
+}
+
+func hexdumpChar(t *Token) string {
+	if t.IsCharacter() && t.Rune == ' ' {
+		return " "
+	}
+	if !t.IsCharacter() || isInvisible(t.Rune) || !unicode.IsGraphic(t.Rune) {
+		return "."
+	}
+	return tableSymbol(t)
+}
+
+func (f *hexdumpFormatter) colorize(s string, t *Token) string {
+	if !f.opts.color {
+		return s
+	}
+	return colorize(s, tokenColor(t))
+}
+
+// note は途中までの行を出力してから msg を出力する
+func (f *hexdumpFormatter) note(msg string) error {
+	if err := f.flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(f.w, msg)
+	return err
+}
+
+func (f *hexdumpFormatter) end() error {
+	return f.flush()
+}
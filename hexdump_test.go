@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestHexdumpFormatter(t *testing.T) {
+
+	input := []byte("Hello, \xe4\xb8\x96\xe7\x95\x8c! e\xcc\x81 \xff\xf0\x9f\x98\x80\n")
+	expected := "00000000: 48 65 6c 6c 6f 2c 20[e4 b8 96|e7 95 8c]21 20 65  Hello, \u4e16 \u754c ! e\n" +
+		"00000010:[cc 81]20 ff[f0 9f 98 80]0a                       \u25cc\u0301  .\U0001f600  .\n"
+
+	buf := bytes.Buffer{}
+	f := newFormatter("hexdump", &buf, &bytes.Buffer{}, options{})
+	parser := NewParser(bufio.NewReader(bytes.NewReader(input)), 8, nil)
+	f.begin()
+	for {
+		token, err := parser.parse()
+		if token != nil {
+			f.write("-", token)
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.end()
+
+	// 複数バイトの Token が括弧で囲まれ、文字が先頭のバイトの位置に並ぶことを確認する
+	if buf.String() != expected {
+		t.Errorf("expected: %q, actual %q", expected, buf.String())
+	}
+
+}
+
+func TestHexdumpSeparator(t *testing.T) {
+
+	a := NewToken('a', TypeOk, []byte{0x61})
+	e := NewToken(0xe9, TypeOk, []byte{0xc3, 0xa9})
+	row := []hexdumpByte{
+		{0x61, a, true, true},
+		{0xc3, e, true, false},
+		{0xa9, e, false, true},
+		{0xc3, e, true, false},
+		{0xa9, e, false, true},
+	}
+
+	expected := []string{" ", "[", " ", "|", " ", "]"}
+	for i, c := range expected {
+		if actual := hexdumpSeparator(row, i); actual != c {
+			t.Errorf("[%d] expected: %q, actual %q", i, c, actual)
+		}
+	}
+
+}
+
+func TestHexdumpFormatterGap(t *testing.T) {
+
+	tokens := []*Token{
+		withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xff}), 1, 1, 1, 2),
+		withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xfe}), 9, 9, 1, 10),
+		withPosition(NewToken('i', TypeOk, []byte{0x69}), 10, 10, 1, 11),
+	}
+	expected := "00000001: ff                                               .\n" +
+		"00000009: fe 69                                            .i\n"
+
+	buf := bytes.Buffer{}
+	f := newFormatter("hexdump", &buf, &bytes.Buffer{}, options{})
+	f.begin()
+	for _, token := range tokens {
+		f.write("-", token)
+	}
+	f.end()
+
+	// 連続していない Token は別の行に正しいオフセットで出力されることを確認する
+	if buf.String() != expected {
+		t.Errorf("expected: %q, actual %q", expected, buf.String())
+	}
+
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// 既定で無視される文字のうち、invisibleSymbols にない未割り当ての範囲を含めたもの
+var defaultIgnorableRanges = []struct {
+	first rune
+	last  rune
+}{
+	{0x2065, 0x2065},
+	{0xfff0, 0xfff8},
+	{0xe0000, 0xe0fff},
+}
+
+// invisibleSymbol は空白文字または既定で無視される文字を表す記号を返す
+func invisibleSymbol(r rune) (string, bool) {
+	if val, ok := invisibleSymbols[r]; ok {
+		return val, true
+	}
+
+	switch {
+	case 0xfe00 <= r && r <= 0xfe0f:
+		return fmt.Sprintf("VS%d", r-0xfe00+1), true
+	case 0xe0100 <= r && r <= 0xe01ef:
+		return fmt.Sprintf("VS%d", r-0xe0100+17), true
+	case r == 0xe0020:
+		return "TAG SP", true
+	case 0xe0021 <= r && r <= 0xe007e:
+		return fmt.Sprintf("TAG %c", r-0xe0000), true
+	case r == 0xe007f:
+		return "CANCEL TAG", true
+	}
+
+	for _, rng := range defaultIgnorableRanges {
+		if rng.first <= r && r <= rng.last {
+			return "(ignorable)", true
+		}
+	}
+	return "", false
+}
+
+// isInvisible は r が空白文字、既定で無視される文字、制御文字のいずれかで、グリフが表示されないかどうかを返す
+func isInvisible(r rune) bool {
+	_, ok := invisibleSymbol(r)
+	return ok || unicode.IsSpace(r) || unicode.IsControl(r)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestTokenSymbolInvisible(t *testing.T) {
+
+	cases := []struct {
+		input    rune
+		expected string
+	}{
+		{'a', "a"},
+		{'\t', "HT"},
+		{' ', "SP"},
+		{0x00a0, "NBSP"},
+		{0x00ad, "SHY"},
+		{0x200b, "ZWSP"},
+		{0x3000, "IDSP"},
+		// 異体字セレクタは番号を付けて表示することを確認する
+		{0xfe0f, "VS16"},
+		{0xe0100, "VS17"},
+		{0xe01ef, "VS256"},
+		// タグ文字は対応する ASCII の文字を表示することを確認する
+		{0xe0020, "TAG SP"},
+		{0xe0041, "TAG A"},
+		{0xe007f, "CANCEL TAG"},
+		// 未割り当ての既定で無視される文字を確認する
+		{0x2065, "(ignorable)"},
+		{0xe0fff, "(ignorable)"},
+	}
+
+	for i, c := range cases {
+		if actual := NewToken(c.input, TypeOk, nil).Symbol(); actual != c.expected {
+			t.Errorf("[%d] expected: %s, actual %s", i, c.expected, actual)
+		}
+	}
+
+}
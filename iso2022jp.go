@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+const (
+	stateASCII         = "ASCII"
+	stateJISRoman      = "JIS X 0201 Roman"
+	stateJISKatakana   = "JIS X 0201 Katakana"
+	stateJISX0208_1978 = "JIS X 0208-1978"
+	stateJISX0208_1983 = "JIS X 0208-1983"
+	stateJISX0212      = "JIS X 0212-1990"
+)
+
+var iso2022jpEscapeSequences = []struct {
+	sequence []byte
+	state    string
+}{
+	{[]byte{0x1b, 0x28, 0x42}, stateASCII},
+	{[]byte{0x1b, 0x28, 0x4a}, stateJISRoman},
+	{[]byte{0x1b, 0x28, 0x49}, stateJISKatakana},
+	{[]byte{0x1b, 0x24, 0x40}, stateJISX0208_1978},
+	{[]byte{0x1b, 0x24, 0x42}, stateJISX0208_1983},
+	{[]byte{0x1b, 0x24, 0x28, 0x44}, stateJISX0212},
+}
+
+func NewISO2022JPParser(reader *bufio.Reader) Parser {
+	return &iso2022jpParser{
+		baseParser: newBaseParser(reader, 1),
+		state:      stateASCII,
+	}
+}
+
+// iso2022jpParser はエスケープシーケンスによる指示の状態を保持しながら ISO-2022-JP をパースする
+type iso2022jpParser struct {
+	baseParser
+	state string
+	ended bool
+}
+
+func (p *iso2022jpParser) parse() (*Token, error) {
+	return p.track(p.parseToken)
+}
+
+func (p *iso2022jpParser) parseToken() (*Token, error) {
+
+	b1, err := p.peekByte()
+	if err != nil {
+		// ASCII 以外の状態のまま入力が終わったときは1度だけ報告する
+		if p.state != stateASCII && !p.ended {
+			p.ended = true
+			return p.newToken(0, TypeUnterminatedShiftState, []byte{}), nil
+		}
+		return nil, err
+	}
+
+	if b1 == 0x1b {
+		for _, e := range iso2022jpEscapeSequences {
+			if bs, _ := p.peek(len(e.sequence)); bytes.Equal(bs, e.sequence) {
+				p.readFull(bs)
+				p.state = e.state
+				return p.newToken(0, TypeEscapeSequence, append([]byte{}, bs...)), nil
+			}
+		}
+
+		// 対応していないエスケープシーケンス(JIS X 0213 や ISO-2022-JP-2 の指示など)は全体を不正なバイト列とする
+		bs := make([]byte, p.escapeLength())
+		p.readFull(bs)
+		return p.newToken(0, TypeInvalidByteSequence, bs), nil
+	}
+
+	p.readByte()
+	bs := []byte{b1}
+
+	// 制御文字と空白はどの状態でもそのまま扱う
+	if b1 <= 0x20 || b1 == 0x7f {
+		return p.newToken(rune(b1), TypeOk, bs), nil
+	} else if b1 >= 0x80 {
+		return p.newToken(0, TypeInvalidByteSequence, bs), nil
+	}
+
+	switch p.state {
+	case stateJISRoman:
+		r := rune(b1)
+		if b1 == 0x5c {
+			r = '¥'
+		} else if b1 == 0x7e {
+			r = '‾'
+		}
+		return p.newToken(r, TypeOk, bs), nil
+	case stateJISKatakana:
+		return p.decode(bs, []byte{0x8e, b1 | 0x80})
+	case stateJISX0208_1978, stateJISX0208_1983, stateJISX0212:
+		b2, err := p.peekByte()
+		if err != nil || b2 < 0x21 || 0x7e < b2 {
+			return p.newToken(0, TypeInvalidByteSequence, bs), nil
+		}
+		p.readByte()
+		bs = append(bs, b2)
+		if p.state == stateJISX0212 {
+			return p.decode(bs, []byte{0x8f, b1 | 0x80, b2 | 0x80})
+		}
+		return p.decode(bs, []byte{b1 | 0x80, b2 | 0x80})
+	}
+
+	return p.newToken(rune(b1), TypeOk, bs), nil
+}
+
+// escapeLength は ESC に続く中間バイト(0x20-0x2F)と終端バイト(0x30-0x7E)までの長さを返す
+// 終端バイトが見つからないときは ESC だけの長さを返す
+func (p *iso2022jpParser) escapeLength() int {
+	bs, _ := p.peek(legacyMaxBytes)
+	for i := 1; i < len(bs); i++ {
+		if 0x20 <= bs[i] && bs[i] <= 0x2f {
+			continue
+		} else if 0x30 <= bs[i] && bs[i] <= 0x7e {
+			return i + 1
+		}
+		break
+	}
+	return 1
+}
+
+// decode は EUC-JP に変換したバイト列を用いて JIS X 0201/0208/0212 の文字をデコードする
+func (p *iso2022jpParser) decode(bs []byte, eucjp []byte) (*Token, error) {
+	decoded, err := japanese.EUCJP.NewDecoder().Bytes(eucjp)
+	r, _ := utf8.DecodeRune(decoded)
+	if err != nil || r == utf8.RuneError {
+		return p.newToken(0, TypeInvalidByteSequence, bs), nil
+	}
+	return p.newToken(r, TypeOk, bs), nil
+}
+
+func (p *iso2022jpParser) newToken(r rune, t int, bs []byte) *Token {
+	token := NewToken(r, t, bs)
+	token.State = p.state
+	return token
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func withState(token *Token, state string) *Token {
+	token.State = state
+	return token
+}
+
+func TestISO2022JPParserParse(t *testing.T) {
+
+	cases := []struct {
+		input    []byte
+		expected []*Token
+	}{
+		// エスケープシーケンスと各状態の文字がパースでき、エスケープシーケンスは桁を占めないことを確認する
+		{
+			input: []byte{
+				0x61,
+				0x1b, 0x24, 0x42, 0x24, 0x22, // あ
+				0x1b, 0x28, 0x4a, 0x5c, // ¥
+				0x1b, 0x28, 0x49, 0x31, // ｱ
+				0x1b, 0x28, 0x42,
+			},
+			expected: []*Token{
+				withState(withPosition(NewToken('a', TypeOk, []byte{0x61}), 0, 0, 1, 1), stateASCII),
+				withState(withPosition(NewToken(0, TypeEscapeSequence, []byte{0x1b, 0x24, 0x42}), 1, 1, 1, 2), stateJISX0208_1983),
+				withState(withPosition(NewToken('あ', TypeOk, []byte{0x24, 0x22}), 4, 4, 1, 2), stateJISX0208_1983),
+				withState(withPosition(NewToken(0, TypeEscapeSequence, []byte{0x1b, 0x28, 0x4a}), 6, 6, 1, 3), stateJISRoman),
+				withState(withPosition(NewToken('¥', TypeOk, []byte{0x5c}), 9, 9, 1, 3), stateJISRoman),
+				withState(withPosition(NewToken(0, TypeEscapeSequence, []byte{0x1b, 0x28, 0x49}), 10, 10, 1, 4), stateJISKatakana),
+				withState(withPosition(NewToken('ｱ', TypeOk, []byte{0x31}), 13, 13, 1, 4), stateJISKatakana),
+				withState(withPosition(NewToken(0, TypeEscapeSequence, []byte{0x1b, 0x28, 0x42}), 14, 14, 1, 5), stateASCII),
+			},
+		},
+		// 2バイト文字の途中で終端したとき TypeInvalidByteSequence を返し、
+		// ASCII 以外の状態のまま終端したとき TypeUnterminatedShiftState を返すことを確認する
+		{
+			input: []byte{
+				0x1b, 0x24, 0x42, 0x24,
+			},
+			expected: []*Token{
+				withState(withPosition(NewToken(0, TypeEscapeSequence, []byte{0x1b, 0x24, 0x42}), 0, 0, 1, 1), stateJISX0208_1983),
+				withState(withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x24}), 3, 3, 1, 1), stateJISX0208_1983),
+				withState(withPosition(NewToken(0, TypeUnterminatedShiftState, []byte{}), 4, 4, 1, 2), stateJISX0208_1983),
+			},
+		},
+		// 対応していないエスケープシーケンスは全体が TypeInvalidByteSequence になり、状態を変えないことを確認する
+		{
+			input: []byte{
+				0x1b, 0x24, 0x28, 0x51, // JIS X 0213 第1面
+				0x21,
+				0x1b, 0x2e, 0x41, // ISO-2022-JP-2 の ISO-8859-1 の指示
+				0x1b, 0x0a,
+			},
+			expected: []*Token{
+				withState(withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x1b, 0x24, 0x28, 0x51}), 0, 0, 1, 1), stateASCII),
+				withState(withPosition(NewToken('!', TypeOk, []byte{0x21}), 4, 4, 1, 2), stateASCII),
+				withState(withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x1b, 0x2e, 0x41}), 5, 5, 1, 3), stateASCII),
+				withState(withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x1b}), 8, 8, 1, 4), stateASCII),
+				withState(withPosition(NewToken('\n', TypeOk, []byte{0x0a}), 9, 9, 1, 5), stateASCII),
+			},
+		},
+	}
+
+	for i, c := range cases {
+		reader := bufio.NewReader(bytes.NewReader(c.input))
+		parser := NewISO2022JPParser(reader)
+
+		for j, expected := range c.expected {
+			actual, err := parser.parse()
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("[%d,%d] expected: %#v, actual %#v", i, j, expected, actual)
+			}
+			if err != nil {
+				t.Errorf("[%d,%d] unexpected error: %#v", i, j, err)
+			}
+		}
+		if actual, err := parser.parse(); actual != nil || err != io.EOF {
+			t.Errorf("[%d] expected: EOF, actual %#v, %#v", i, actual, err)
+		}
+	}
+
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/transform"
+)
+
+// 1文字を構成するバイト列の最大長(GB18030 の4バイト、ISO-2022-JP のエスケープシーケンス)
+const legacyMaxBytes = 8
+
+// NewLegacyParser は golang.org/x/text/encoding に対応した文字コードの Parser を返す
+// 対応していない文字コードのときは nil を返す
+func NewLegacyParser(reader *bufio.Reader, charset string) Parser {
+	enc, err := ianaindex.IANA.Encoding(charset)
+	if err != nil || enc == nil {
+		return nil
+	}
+	// U+FFFD を符号化できない文字コードでは nil になる
+	replacement, _ := enc.NewEncoder().Bytes([]byte(string(utf8.RuneError)))
+	return &legacyParser{
+		baseParser:  newBaseParser(reader, 1),
+		decoder:     enc.NewDecoder(),
+		replacement: replacement,
+	}
+}
+
+type legacyParser struct {
+	baseParser
+	decoder *encoding.Decoder
+	// U+FFFD を符号化したバイト列。デコーダが不正なバイト列の代わりに出力する U+FFFD と区別する
+	replacement []byte
+	// 1つのバイト列から複数の符号位置がデコードされたときの2つ目以降の符号位置
+	pending []rune
+}
+
+func (p *legacyParser) parse() (*Token, error) {
+	return p.track(p.parseToken)
+}
+
+// parseToken は1文字分のバイト列が揃うまで入力を1バイトずつ伸ばしながらデコードする
+func (p *legacyParser) parseToken() (*Token, error) {
+	// 2つ目以降の符号位置は、バイト列を持たない Token として続けて返す
+	if len(p.pending) > 0 {
+		r := p.pending[0]
+		p.pending = p.pending[1:]
+		return NewToken(r, TypeOk, []byte{}), nil
+	}
+
+	src, err := p.peek(legacyMaxBytes)
+	if len(src) == 0 {
+		return nil, err
+	}
+	atEOF := len(src) < legacyMaxBytes
+
+	dst := make([]byte, utf8.UTFMax*2)
+	for n := 1; n <= len(src); n++ {
+		nDst, nSrc, terr := p.decoder.Transform(dst, src[:n], atEOF && n == len(src))
+		if terr == transform.ErrShortSrc && nSrc == 0 {
+			continue
+		}
+		if nSrc == 0 {
+			break
+		}
+
+		bs := make([]byte, nSrc)
+		p.readFull(bs)
+
+		if nDst == 0 {
+			// 文字を出力せずに消費されたバイト列(ISO-2022-JP のエスケープシーケンスなど)
+			return NewToken(0, TypeEscapeSequence, bs), nil
+		}
+
+		runes := []rune(string(dst[:nDst]))
+		for _, r := range runes {
+			if r == utf8.RuneError && !bytes.Equal(bs, p.replacement) {
+				return NewToken(0, TypeInvalidByteSequence, bs), nil
+			}
+		}
+		p.pending = runes[1:]
+		return NewToken(runes[0], TypeOk, bs), nil
+	}
+
+	// デコーダがバイト列を消費できなかったときは1バイトを不正なバイトとして読み飛ばす
+	b, _ := p.readByte()
+	return NewToken(0, TypeInvalidByteSequence, []byte{b}), nil
+}
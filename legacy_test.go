@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestLegacyParserParse(t *testing.T) {
+
+	cases := []struct {
+		charset  string
+		input    []byte
+		expected []*Token
+	}{
+		// 複数バイトの文字が元のバイト列とともにパースできることを確認する
+		{
+			charset: "Shift_JIS",
+			input:   []byte{0x61, 0x82, 0xa0, 0xb1, 0x81},
+			expected: []*Token{
+				withPosition(NewToken('a', TypeOk, []byte{0x61}), 0, 0, 1, 1),
+				withPosition(NewToken('あ', TypeOk, []byte{0x82, 0xa0}), 1, 1, 1, 2),
+				withPosition(NewToken('ｱ', TypeOk, []byte{0xb1}), 3, 3, 1, 3),
+				withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x81}), 4, 4, 1, 4),
+			},
+		},
+		{
+			charset: "EUC-JP",
+			input:   []byte{0xa4, 0xa2, 0x8f, 0xab, 0xb1},
+			expected: []*Token{
+				withPosition(NewToken('あ', TypeOk, []byte{0xa4, 0xa2}), 0, 0, 1, 1),
+				withPosition(NewToken('é', TypeOk, []byte{0x8f, 0xab, 0xb1}), 2, 2, 1, 2),
+			},
+		},
+		{
+			charset: "GB18030",
+			input:   []byte{0xd6, 0xd0, 0x83, 0x36, 0x84, 0x33},
+			expected: []*Token{
+				withPosition(NewToken('中', TypeOk, []byte{0xd6, 0xd0}), 0, 0, 1, 1),
+				withPosition(NewToken('한', TypeOk, []byte{0x83, 0x36, 0x84, 0x33}), 2, 2, 1, 2),
+			},
+		},
+		// 1つのバイト列から複数の符号位置がデコードされるときは、すべての符号位置が返されることを確認する
+		{
+			charset: "Big5",
+			input:   []byte{0x88, 0x62, 0x61},
+			expected: []*Token{
+				withPosition(NewToken(0xca, TypeOk, []byte{0x88, 0x62}), 0, 0, 1, 1),
+				withPosition(NewToken(0x304, TypeOk, []byte{}), 2, 2, 1, 2),
+				withPosition(NewToken('a', TypeOk, []byte{0x61}), 2, 2, 1, 3),
+			},
+		},
+		// U+FFFD を正しく符号化したバイト列は不正なバイト列として扱わないことを確認する
+		{
+			charset: "GB18030",
+			input:   []byte{0x84, 0x31, 0xa4, 0x37, 0xff},
+			expected: []*Token{
+				withPosition(NewToken(0xfffd, TypeOk, []byte{0x84, 0x31, 0xa4, 0x37}), 0, 0, 1, 1),
+				withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xff}), 4, 4, 1, 2),
+			},
+		},
+		// 1バイトの文字コードがパースできることを確認する
+		{
+			charset: "windows-1252",
+			input:   []byte{0x80, 0xe9},
+			expected: []*Token{
+				withPosition(NewToken('€', TypeOk, []byte{0x80}), 0, 0, 1, 1),
+				withPosition(NewToken('é', TypeOk, []byte{0xe9}), 1, 1, 1, 2),
+			},
+		},
+		// エスケープシーケンスが TypeEscapeSequence になることを確認する
+		{
+			charset: "ISO-2022-JP",
+			input:   []byte{0x1b, 0x24, 0x42, 0x24, 0x22, 0x1b, 0x28, 0x42},
+			expected: []*Token{
+				withPosition(NewToken(0, TypeEscapeSequence, []byte{0x1b, 0x24, 0x42}), 0, 0, 1, 1),
+				withPosition(NewToken('あ', TypeOk, []byte{0x24, 0x22}), 3, 3, 1, 1),
+				withPosition(NewToken(0, TypeEscapeSequence, []byte{0x1b, 0x28, 0x42}), 5, 5, 1, 2),
+			},
+		},
+	}
+
+	for i, c := range cases {
+		reader := bufio.NewReader(bytes.NewReader(c.input))
+		parser := NewLegacyParser(reader, c.charset)
+
+		for j, expected := range c.expected {
+			actual, err := parser.parse()
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("[%d,%d] expected: %#v, actual %#v", i, j, expected, actual)
+			}
+			if err != nil {
+				t.Errorf("[%d,%d] unexpected error: %#v", i, j, err)
+			}
+		}
+		if actual, err := parser.parse(); actual != nil || err != io.EOF {
+			t.Errorf("[%d] expected: EOF, actual %#v, %#v", i, actual, err)
+		}
+	}
+
+	// 対応していない文字コードのとき nil を返すことを確認する
+	if parser := NewLegacyParser(bufio.NewReader(bytes.NewReader(nil)), "UNKNOWN"); parser != nil {
+		t.Errorf("expected: nil, actual %#v", parser)
+	}
+
+}
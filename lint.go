@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// 前後に表示するバイト数
+const lintContextBytes = 4
+
+// contextFormatter は Token の前後のバイト列を必要とする formatter が実装する
+type contextFormatter interface {
+	setContext(before []byte, after []byte)
+}
+
+// lintFormatter は問題のある Token を file:offset: message の形式で出力する
+type lintFormatter struct {
+	w        io.Writer
+	errw     io.Writer
+	position bool
+	color    bool
+	before   []byte
+	after    []byte
+}
+
+func (f *lintFormatter) begin() error {
+	return nil
+}
+
+func (f *lintFormatter) setContext(before []byte, after []byte) {
+	f.before = before
+	f.after = after
+}
+
+func (f *lintFormatter) write(path string, token *Token) error {
+	location := fmt.Sprintf("%d", token.Offset)
+	if f.position {
+		location = token.Position()
+	}
+
+	context := []string{}
+	if hex := hexBytes(f.before); hex != "" {
+		context = append(context, hex)
+	}
+	context = append(context, "["+token.Hex()+"]")
+	if hex := hexBytes(f.after); hex != "" {
+		context = append(context, hex)
+	}
+
+	message := problemMessage(token)
+	if f.color {
+		message = colorize(message, tokenColor(token))
+	}
+
+	_, err := fmt.Fprintf(f.w, "%s:%s: %s (context: %s)\n", path, location, message, strings.Join(context, " "))
+	return err
+}
+
+func (f *lintFormatter) note(msg string) error {
+	_, err := fmt.Fprintln(f.errw, msg)
+	return err
+}
+
+func (f *lintFormatter) end() error {
+	return nil
+}
+
+func problemMessage(t *Token) string {
+	if t.Type == TypeInvalidByteSequence {
+		return "Invalid byte sequence"
+	} else if t.Type == TypeIncompleteSurrogatePair {
+		return "Incomplete surrogate pair"
+	} else if t.HasRune() {
+		return fmt.Sprintf("%s (%s)", t.Label(), t.CodePoint())
+	}
+	return t.Label()
+}
+
+// contextBuffer は直前に読み込んだバイト列を保持する
+type contextBuffer struct {
+	bytes []byte
+}
+
+func (b *contextBuffer) add(bs []byte) {
+	b.bytes = append(b.bytes, bs...)
+	if len(b.bytes) > lintContextBytes {
+		b.bytes = b.bytes[len(b.bytes)-lintContextBytes:]
+	}
+}
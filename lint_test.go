@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLintFormatter(t *testing.T) {
+
+	cases := []struct {
+		token    *Token
+		position bool
+		before   []byte
+		after    []byte
+		expected string
+	}{
+		// 前後のバイト列とともにオフセットを出力することを確認する
+		{
+			token:    withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xff}), 5, 5, 1, 6),
+			before:   []byte{0x65, 0x6c, 0x6c, 0x6f},
+			after:    []byte{0x77},
+			expected: "a.txt:5: Invalid byte sequence (context: 65 6c 6c 6f [ff] 77)\n",
+		},
+		// -position のとき行番号と桁番号を出力することを確認する
+		{
+			token:    withPosition(NewToken(0xd800, TypeSurrogateInUTF8, []byte{0xed, 0xa0, 0x80}), 0, 0, 1, 1),
+			position: true,
+			expected: "a.txt:1:1: Surrogate code point in UTF-8 (U+D800) (context: [ed a0 80])\n",
+		},
+	}
+
+	for i, c := range cases {
+		buf := bytes.Buffer{}
+		f := &lintFormatter{w: &buf, position: c.position}
+		f.setContext(c.before, c.after)
+		f.write("a.txt", c.token)
+
+		if buf.String() != c.expected {
+			t.Errorf("[%d] expected: %q, actual %q", i, c.expected, buf.String())
+		}
+	}
+
+}
+
+func TestLintFormatterNote(t *testing.T) {
+
+	buf := bytes.Buffer{}
+	errBuf := bytes.Buffer{}
+	f := newFormatter("text", &buf, &errBuf, options{errorsOnly: true})
+	f.note("note")
+
+	// note は問題の一覧に混ざらないよう別の出力先に書き出すことを確認する
+	if buf.String() != "" || errBuf.String() != "note\n" {
+		t.Errorf("expected: %q, actual %q, %q", "note\n", buf.String(), errBuf.String())
+	}
+
+}
+
+func TestContextBuffer(t *testing.T) {
+
+	b := contextBuffer{}
+	b.add([]byte{0x61, 0x62})
+	b.add([]byte{0xe3, 0x81, 0x82})
+
+	// 直近の lintContextBytes バイトだけを保持することを確認する
+	expected := []byte{0x62, 0xe3, 0x81, 0x82}
+	if !bytes.Equal(b.bytes, expected) {
+		t.Errorf("expected: %#v, actual %#v", expected, b.bytes)
+	}
+
+}
@@ -2,45 +2,224 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/binary"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 )
 
+type options struct {
+	charset       string
+	position      bool
+	format        string
+	summary       bool
+	strict        bool
+	errorsOnly    bool
+	category      bool
+	script        bool
+	block         bool
+	aliases       bool
+	graphemes     bool
+	normalization bool
+	confusables   bool
+	bidi          bool
+	highlight     bool
+	color         bool
+}
+
 func main() {
-	var charset string
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "encode":
+			os.Exit(runEncode(os.Args[2:]))
+		case "search":
+			os.Exit(runSearch(os.Args[2:]))
+		}
+	}
+
+	var opts options
 
-	flag.StringVar(&charset, "c", "UTF-8", "select character set (UTF-8 | UTF-16 | UTF-16BE | UTF-16LE | UTF-32 | UTF-32BE | UTF-32LE)")
+	flag.StringVar(&opts.charset, "c", "UTF-8", "select character set (UTF-8 | UTF-16 | UTF-16BE | UTF-16LE | UTF-32 | UTF-32BE | UTF-32LE | auto | Shift_JIS | EUC-JP | ISO-2022-JP | GB18030 | Big5 | EUC-KR | windows-125x | ISO-8859-x)")
+	flag.BoolVar(&opts.position, "position", false, "print line and column number of each character")
+	flag.BoolVar(&opts.category, "category", false, "print general category (Lu, Mn, Cf ...) of each character")
+	flag.BoolVar(&opts.script, "script", false, "print script of each character")
+	flag.BoolVar(&opts.block, "block", false, "print block name of each character")
+	flag.BoolVar(&opts.aliases, "aliases", false, "print name aliases (correction, control, alternate, figment, abbreviation) of each character")
+	flag.BoolVar(&opts.graphemes, "graphemes", false, "group characters into extended grapheme clusters (UAX #29), only with -format text")
+	flag.BoolVar(&opts.normalization, "normalization", false, "print whether each character is stable under NFC, NFD, NFKC and NFKD (with -graphemes, also for each cluster)")
+	flag.BoolVar(&opts.confusables, "confusables", false, "print UTS #39 skeleton of characters confusable with others and flag mixed-script confusables")
+	flag.BoolVar(&opts.bidi, "bidi", false, "print bidi class of each character and report unterminated, nested or unmatched bidi controls (U+202A-U+202E, U+2066-U+2069) per line")
+	flag.BoolVar(&opts.highlight, "highlight", false, "highlight symbols of whitespace, default ignorable and control characters when output is colored")
+	colorMode := flag.String("color", "auto", "colorize text output (auto | always | never), auto honors NO_COLOR")
+	flag.StringVar(&opts.format, "format", "text", "select output format (text | table | hexdump | json | jsonl | csv)")
+	flag.BoolVar(&opts.summary, "summary", false, "print statistics of tokens, blocks and scripts at the end")
+	flag.BoolVar(&opts.errorsOnly, "errors-only", false, "print only invalid tokens as file:offset: message")
+	flag.BoolVar(&opts.strict, "strict", false, "exit with status 3 when any token other than a valid character is found")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [options] [file ...]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s encode code-point ...\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s search [-r] pattern\n", os.Args[0])
+		fmt.Fprintln(flag.CommandLine.Output(), "Reads standard input when no file or - is given.")
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
-	reader := bufio.NewReader(os.Stdin)
-	var parser Parser
-	charset = strings.ToUpper(charset)
+	opts.charset = strings.ToUpper(opts.charset)
+	if opts.charset != "AUTO" && newCharsetParser(bufio.NewReader(bytes.NewReader(nil)), opts.charset) == nil {
+		flag.Usage()
+		os.Exit(2)
+	}
 
-	if charset == "UTF-8" {
-		parser = NewParser(reader, 8, nil)
-	} else if charset == "UTF-16" || charset == "UTF-16BE" {
-		parser = NewParser(reader, 16, binary.BigEndian)
-	} else if charset == "UTF-16LE" {
-		parser = NewParser(reader, 16, binary.LittleEndian)
-	} else if charset == "UTF-32" || charset == "UTF-32BE" {
-		parser = NewParser(reader, 32, binary.BigEndian)
-	} else if charset == "UTF-32LE" {
-		parser = NewParser(reader, 32, binary.LittleEndian)
-	} else {
+	var ok bool
+	if opts.color, ok = useColor(strings.ToLower(*colorMode), os.Stdout); !ok {
 		flag.Usage()
+		os.Exit(2)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	opts.format = strings.ToLower(opts.format)
+	if err := checkGraphemes(opts.format, opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	f := newFormatter(opts.format, out, os.Stderr, opts)
+	if f == nil {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	stats := newSummary()
+	f.begin()
+	status := dumpFiles(paths, os.Stdin, f, stats, opts, func(err error) {
+		out.Flush()
+		fmt.Fprintln(os.Stderr, err)
+	})
+	if opts.summary {
+		f.note(stats.String())
+	}
+	f.end()
+	if status == 0 && opts.strict && stats.invalid > 0 {
+		status = 3
+	}
+
+	out.Flush()
+	os.Exit(status)
+}
+
+// dumpFiles は paths のファイルを順に dump し、終了ステータスを返す
+// 開けないファイルがあっても report でエラーを報告して残りのファイルを処理し、終了ステータスを 1 にする
+func dumpFiles(paths []string, stdin io.Reader, f formatter, stats *summary, opts options, report func(error)) int {
+	status := 0
+	for i, path := range paths {
+		// テキスト、表、16進ダンプ以外の形式と -errors-only では各 Token にファイル名を含める
+		if len(paths) > 1 && (opts.format == "text" || opts.format == "table" || opts.format == "hexdump") && !opts.errorsOnly {
+			if i > 0 {
+				f.note("")
+			}
+			f.note(fmt.Sprintf("==> %s <==", path))
+		}
+		if err := dumpFile(path, stdin, f, stats, opts); err != nil {
+			report(err)
+			status = 1
+		}
+	}
+	return status
+}
+
+// dumpFile は path のファイルを開いて dump する。path が - のときは stdin を読み込む
+func dumpFile(path string, stdin io.Reader, f formatter, stats *summary, opts options) error {
+	if path == "-" {
+		return dump(stdin, path, f, stats, opts)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return dump(file, path, f, stats, opts)
+}
+
+func dump(r io.Reader, path string, f formatter, stats *summary, opts options) error {
+	reader := bufio.NewReader(r)
+	charset := opts.charset
+
+	if charset == "AUTO" {
+		var confidence float64
+		charset, confidence = detectEncoding(reader)
+		f.note(fmt.Sprintf("encoding: %s (confidence: %.2f)", charset, confidence))
+	}
+
+	parser := newCharsetParser(reader, charset)
+	if opts.confusables {
+		parser = newConfusableParser(parser)
 	}
+	before := contextBuffer{}
+	tracker := bidiTracker{}
 
 	for {
 		token, err := parser.parse()
 		if token != nil {
-			fmt.Println(token)
+			if opts.summary || opts.strict {
+				stats.add(token)
+			}
+			if !opts.errorsOnly || !token.IsValid() {
+				if cf, ok := f.(contextFormatter); ok {
+					after, _ := reader.Peek(lintContextBytes)
+					cf.setContext(before.bytes, after)
+				}
+				if err := f.write(path, token); err != nil {
+					return err
+				}
+			}
+			before.add(token.Bytes)
+			if opts.bidi {
+				for _, problem := range tracker.add(path, token) {
+					f.note(problem)
+				}
+			}
 		}
-		if err != nil {
-			break
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			if opts.bidi {
+				for _, problem := range tracker.flush(path) {
+					f.note(problem)
+				}
+			}
+			return nil
+		} else if err != nil {
+			return err
 		}
 	}
+}
 
+func newCharsetParser(reader *bufio.Reader, charset string) Parser {
+	if charset == "UTF-8" {
+		return NewParser(reader, 8, nil)
+	} else if charset == "UTF-16" {
+		return NewParserWithBOM(reader, 16, binary.BigEndian)
+	} else if charset == "UTF-16BE" {
+		return NewParser(reader, 16, binary.BigEndian)
+	} else if charset == "UTF-16LE" {
+		return NewParser(reader, 16, binary.LittleEndian)
+	} else if charset == "UTF-32" {
+		return NewParserWithBOM(reader, 32, binary.BigEndian)
+	} else if charset == "UTF-32BE" {
+		return NewParser(reader, 32, binary.BigEndian)
+	} else if charset == "UTF-32LE" {
+		return NewParser(reader, 32, binary.LittleEndian)
+	} else if charset == "ISO-2022-JP" {
+		return NewISO2022JPParser(reader)
+	}
+	return NewLegacyParser(reader, charset)
 }
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDumpFiles(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "dump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	a := filepath.Join(dir, "a.txt")
+	b := filepath.Join(dir, "b.txt")
+	missing := filepath.Join(dir, "missing.txt")
+	ioutil.WriteFile(a, []byte("a"), 0644)
+	ioutil.WriteFile(b, []byte("b"), 0644)
+
+	lineA := "00000000\ta\tU+0061\t61\tLATIN SMALL LETTER A\n"
+	lineB := "00000000\tb\tU+0062\t62\tLATIN SMALL LETTER B\n"
+	lineC := "00000000\tc\tU+0063\t63\tLATIN SMALL LETTER C\n"
+
+	cases := []struct {
+		paths    []string
+		opts     options
+		expected string
+		errors   int
+		status   int
+	}{
+		// 1つのファイルではヘッダを出力しないことを確認する
+		{[]string{a}, options{format: "text"}, lineA, 0, 0},
+		// - は標準入力を読み込むことを確認する
+		{[]string{"-"}, options{format: "text"}, lineC, 0, 0},
+		// 複数のファイルではファイルごとにヘッダを出力することを確認する
+		{
+			[]string{a, "-", b},
+			options{format: "text"},
+			"==> " + a + " <==\n" + lineA + "\n==> - <==\n" + lineC + "\n==> " + b + " <==\n" + lineB,
+			0, 0,
+		},
+		// 開けないファイルがあっても残りのファイルを処理し、終了ステータスが 1 になることを確認する
+		{
+			[]string{a, missing, b},
+			options{format: "text"},
+			"==> " + a + " <==\n" + lineA + "\n==> " + missing + " <==\n\n==> " + b + " <==\n" + lineB,
+			1, 1,
+		},
+		// JSON Lines ではヘッダを出力せず、各 Token にファイル名を含めることを確認する
+		{
+			[]string{a, b},
+			options{format: "jsonl"},
+			`{"file":"` + a + `","offset":0,"index":0,"line":1,"column":1,"codepoint":"U+0061","bytes":"61","type":"Ok","name":"LATIN SMALL LETTER A","category":"Ll"}` + "\n" +
+				`{"file":"` + b + `","offset":0,"index":0,"line":1,"column":1,"codepoint":"U+0062","bytes":"62","type":"Ok","name":"LATIN SMALL LETTER B","category":"Ll"}` + "\n",
+			0, 0,
+		},
+	}
+
+	for i, c := range cases {
+		c.opts.charset = "UTF-8"
+		buf := bytes.Buffer{}
+		f := newFormatter(c.opts.format, &buf, &bytes.Buffer{}, c.opts)
+		errors := []error{}
+
+		f.begin()
+		status := dumpFiles(c.paths, strings.NewReader("c"), f, newSummary(), c.opts, func(err error) {
+			errors = append(errors, err)
+		})
+		f.end()
+
+		if buf.String() != c.expected {
+			t.Errorf("[%d] expected: %q, actual %q", i, c.expected, buf.String())
+		}
+		if len(errors) != c.errors || status != c.status {
+			t.Errorf("[%d] expected: %d errors, status %d, actual %v, %d", i, c.errors, c.status, errors, status)
+		}
+	}
+
+}
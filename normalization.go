@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var normalizationForms = []struct {
+	name string
+	form norm.Form
+}{
+	{"NFC", norm.NFC},
+	{"NFD", norm.NFD},
+	{"NFKC", norm.NFKC},
+	{"NFKD", norm.NFKD},
+}
+
+// normalizationColumn は s が各正規化形式で変化しないかどうかと、変化するときの正規化後の符号位置を返す
+func normalizationColumn(s string) string {
+	columns := []string{}
+	for _, f := range normalizationForms {
+		normalized := f.form.String(s)
+		if normalized == s {
+			columns = append(columns, f.name+": stable")
+		} else {
+			columns = append(columns, f.name+": "+codePoints(normalized))
+		}
+	}
+	return strings.Join(columns, "; ")
+}
+
+func codePoints(s string) string {
+	cps := []string{}
+	for _, r := range s {
+		cps = append(cps, fmt.Sprintf("%U", r))
+	}
+	return strings.Join(cps, " ")
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestNormalizationColumn(t *testing.T) {
+
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"a", "NFC: stable; NFD: stable; NFKC: stable; NFKD: stable"},
+		// 合成済み文字は NFD で分解されることを確認する
+		{"\u00e9", "NFC: stable; NFD: U+0065 U+0301; NFKC: stable; NFKD: U+0065 U+0301"},
+		// 結合文字の並びは NFC で合成されることを確認する
+		{"e\u0301", "NFC: U+00E9; NFD: stable; NFKC: U+00E9; NFKD: stable"},
+		// 互換分解は NFKC/NFKD でのみ変化することを確認する
+		{"\ufb01", "NFC: stable; NFD: stable; NFKC: U+0066 U+0069; NFKD: U+0066 U+0069"},
+		// 正規等価な別の文字に置き換えられることを確認する
+		{"\u212b", "NFC: U+00C5; NFD: U+0041 U+030A; NFKC: U+00C5; NFKD: U+0041 U+030A"},
+	}
+
+	for i, c := range cases {
+		if actual := normalizationColumn(c.input); actual != c.expected {
+			t.Errorf("[%d] expected: %s, actual %s", i, c.expected, actual)
+		}
+	}
+
+}
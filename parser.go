@@ -16,29 +16,32 @@ const (
 	TypeInvalidByteSequence
 	TypeRedundantEncoding
 	TypeIncompleteSurrogatePair
+	TypeSurrogateInUTF8
+	TypeCESU8
+	TypeOutOfRange
+	TypeObsoleteSequence
+	TypeByteOrderMark
+	TypeMisplacedByteOrderMark
+	TypeEscapeSequence
+	TypeUnterminatedShiftState
+	TypeSurrogateInUTF32
 )
 
 func NewParser(reader *bufio.Reader, bit int, byteOrder binary.ByteOrder) Parser {
 
 	if bit == 8 {
 		return &utf8Parser{
-			baseParser: baseParser{
-				reader: reader,
-			},
+			baseParser: newBaseParser(reader, 1),
 		}
 	} else if bit == 16 {
 		return &utf16Parser{
-			baseParser: baseParser{
-				reader: reader,
-			},
-			ByteOrder: byteOrder,
+			baseParser: newBaseParser(reader, 2),
+			ByteOrder:  byteOrder,
 		}
 	} else if bit == 32 {
 		return &utf32Parser{
-			baseParser: baseParser{
-				reader: reader,
-			},
-			ByteOrder: byteOrder,
+			baseParser: newBaseParser(reader, 4),
+			ByteOrder:  byteOrder,
 		}
 	}
 
@@ -50,9 +53,17 @@ type Parser interface {
 }
 
 type Token struct {
-	Rune  rune
-	Bytes []byte
-	Type  int
+	Rune   rune
+	Bytes  []byte
+	Type   int
+	Offset int64
+	Index  int64
+	Line   int64
+	Column int64
+	State  string
+	// -confusables を指定したときに設定する UTS #39 の skeleton と、複数の用字が混在する単語に含まれるかどうか
+	Skeleton    string
+	MixedScript bool
 }
 
 func NewToken(Rune rune, Type int, Bytes []byte) *Token {
@@ -64,46 +75,245 @@ func NewToken(Rune rune, Type int, Bytes []byte) *Token {
 	return &token
 }
 
-func (t *Token) String() string {
+var typeNames = map[int]string{
+	TypeOk:                      "Ok",
+	TypeInvalidByteSequence:     "InvalidByteSequence",
+	TypeRedundantEncoding:       "RedundantEncoding",
+	TypeIncompleteSurrogatePair: "IncompleteSurrogatePair",
+	TypeSurrogateInUTF8:         "SurrogateInUTF8",
+	TypeCESU8:                   "CESU8",
+	TypeOutOfRange:              "OutOfRange",
+	TypeObsoleteSequence:        "ObsoleteSequence",
+	TypeByteOrderMark:           "ByteOrderMark",
+	TypeMisplacedByteOrderMark:  "MisplacedByteOrderMark",
+	TypeEscapeSequence:          "EscapeSequence",
+	TypeUnterminatedShiftState:  "UnterminatedShiftState",
+	TypeSurrogateInUTF32:        "SurrogateInUTF32",
+}
+
+func (t *Token) TypeName() string {
+	return typeNames[t.Type]
+}
+
+// HasRune は Token が符号位置を持つかどうかを返す
+func (t *Token) HasRune() bool {
+	return t.Type != TypeInvalidByteSequence && t.Type != TypeIncompleteSurrogatePair &&
+		t.Type != TypeEscapeSequence && t.Type != TypeUnterminatedShiftState
+}
+
+// IsCharacter は Token の符号位置が Unicode のスカラー値かどうかを返す
+func (t *Token) IsCharacter() bool {
+	return t.HasRune() && t.Type != TypeSurrogateInUTF8 && t.Type != TypeSurrogateInUTF32 &&
+		t.Type != TypeOutOfRange && t.Type != TypeObsoleteSequence
+}
+
+func (t *Token) Hex() string {
+	return hexBytes(t.Bytes)
+}
+
+func hexBytes(bs []byte) string {
 	s := []string{}
-	for _, b := range t.Bytes {
+	for _, b := range bs {
 		s = append(s, fmt.Sprintf("%02x", b))
 	}
+	return strings.Join(s, " ")
+}
+
+// IsValid は Token が正しい入力を表すかどうかを返す。BOM とエスケープシーケンスは正しい入力として扱う
+func (t *Token) IsValid() bool {
+	return t.Type == TypeOk || t.Type == TypeByteOrderMark || t.Type == TypeEscapeSequence
+}
 
-	var c, name string
+// Symbol は文字そのもの、または制御文字、空白文字、既定で無視される文字を表す記号を返す
+func (t *Token) Symbol() string {
+	if !t.IsCharacter() {
+		return ""
+	}
+	if val, ok := invisibleSymbol(t.Rune); ok {
+		return val
+	}
 	if !unicode.IsControl(t.Rune) {
-		c = fmt.Sprintf("%c", t.Rune)
-		name = runenames.Name(t.Rune)
-	} else {
-		if val, ok := controlCodeSymbols[t.Rune]; ok {
-			c = val
-		} else {
-			c = "(control)"
-		}
-		name = runenames.Name(t.Rune)
-		if val, ok := controlCodeAliases[t.Rune]; ok {
-			name += " " + val
+		return fmt.Sprintf("%c", t.Rune)
+	}
+	if val, ok := controlCodeSymbols[t.Rune]; ok {
+		return val
+	}
+	return "(control)"
+}
+
+func (t *Token) Name() string {
+	if !t.IsCharacter() {
+		return ""
+	}
+	return runenames.Name(t.Rune)
+}
+
+func (t *Token) Alias() string {
+	if !t.IsCharacter() {
+		return ""
+	}
+	return controlCodeAliases[t.Rune]
+}
+
+// NameAliases は NameAliases.txt に定義された別名を返す
+func (t *Token) NameAliases() []nameAlias {
+	if !t.IsCharacter() {
+		return nil
+	}
+	return nameAliases[t.Rune]
+}
+
+// Label は Token の種類や状態を説明する文字列を返す
+func (t *Token) Label() string {
+	switch t.Type {
+	case TypeOk:
+		return t.State
+	case TypeRedundantEncoding:
+		return "Redundant encoding"
+	case TypeSurrogateInUTF8:
+		return "Surrogate code point in UTF-8"
+	case TypeSurrogateInUTF32:
+		return "Surrogate code point in UTF-32"
+	case TypeCESU8:
+		return "CESU-8 surrogate pair"
+	case TypeByteOrderMark:
+		return "Byte order mark"
+	case TypeMisplacedByteOrderMark:
+		return "Warning: byte order mark not at start of input"
+	case TypeEscapeSequence:
+		if t.State != "" {
+			return "Escape sequence: designate " + t.State
 		}
+		return "Escape sequence"
+	case TypeUnterminatedShiftState:
+		return "Input ended in " + t.State + " state without returning to ASCII"
+	case TypeOutOfRange:
+		return "Out of range: Unicode is restricted to U+0000..U+10FFFF"
+	case TypeObsoleteSequence:
+		return fmt.Sprintf("Obsolete %d-byte sequence: RFC 3629 allows at most 4 bytes", len(t.Bytes))
+	}
+	return ""
+}
+
+func (t *Token) String() string {
+	return fmt.Sprintf("%08x\t%s", t.Offset, t.Columns())
+}
+
+// Columns は String からオフセットを除いた、文字、符号位置、バイト列、名前の列を返す
+func (t *Token) Columns() string {
+	label := t.Label()
+	if label != "" {
+		label = "[" + label + "]"
 	}
 
-	if t.Type == TypeOk {
-		return fmt.Sprintf("%s\t%U\t%s\t%s", c, t.Rune, strings.Join(s, " "), name)
-	} else if t.Type == TypeRedundantEncoding {
-		return fmt.Sprintf("%s\t%U\t%s\t[Redundant encoding]%s", c, t.Rune, strings.Join(s, " "), name)
+	if !t.HasRune() {
+		return fmt.Sprintf("\t\t%s\t%s", t.Hex(), label)
+	} else if !t.IsCharacter() {
+		return fmt.Sprintf("\t%s\t%s\t%s", t.CodePoint(), t.Hex(), label)
 	}
-	return fmt.Sprintf("\t\t%s\t", strings.Join(s, " "))
+
+	name := t.Name()
+	if alias := t.Alias(); alias != "" {
+		name += " " + alias
+	}
+	return fmt.Sprintf("%s\t%U\t%s\t%s%s", t.Symbol(), t.Rune, t.Hex(), label, name)
+}
+
+// CodePoint は符号位置を U+XXXX の形式で返す。UTF-32 の 0x80000000 以上の値も符号なしで表す
+func (t *Token) CodePoint() string {
+	return fmt.Sprintf("%U", uint32(t.Rune))
+}
+
+func (t *Token) Position() string {
+	return fmt.Sprintf("%d:%d", t.Line, t.Column)
+}
+
+func isLineTerminator(r rune) bool {
+	return r == '\n' || r == '\r' || r == 0x85 || r == 0x2028 || r == 0x2029
 }
 
 type baseParser struct {
-	reader *bufio.Reader
+	reader    *bufio.Reader
+	unitSize  int64
+	offset    int64
+	line      int64
+	column    int64
+	pendingCR bool
+}
+
+func newBaseParser(reader *bufio.Reader, unitSize int64) baseParser {
+	return baseParser{
+		reader:   reader,
+		unitSize: unitSize,
+		line:     1,
+		column:   1,
+	}
+}
+
+// track は parse の結果に先頭バイトのオフセット、コードユニットのインデックス、行番号と桁番号を設定する
+func (p *baseParser) track(parse func() (*Token, error)) (*Token, error) {
+	start := p.offset
+	token, err := parse()
+	if token == nil {
+		return token, err
+	}
+	token.Offset = start
+	token.Index = start / p.unitSize
+
+	if token.Type == TypeOk && token.Rune == 0xfeff {
+		if start == 0 {
+			token.Type = TypeByteOrderMark
+		} else {
+			token.Type = TypeMisplacedByteOrderMark
+		}
+	}
+
+	isLF := token.Type == TypeOk && token.Rune == '\n'
+	// CRLF は1つの改行として扱うため、CR の直後が LF でないときに改行する
+	if p.pendingCR && !isLF {
+		p.newLine()
+	}
+	token.Line = p.line
+	token.Column = p.column
+	if advancesColumn(token) {
+		p.column++
+	}
+
+	p.pendingCR = false
+	if token.Type == TypeOk && isLineTerminator(token.Rune) {
+		if token.Rune == '\r' {
+			p.pendingCR = true
+		} else {
+			p.newLine()
+		}
+	}
+	return token, err
+}
+
+// advancesColumn は Token が入力中の文字として桁を占めるかどうかを返す
+// 先頭の BOM、エスケープシーケンス、シフト状態の終端漏れは文字を表さないため、次の文字と同じ桁に置く
+func advancesColumn(t *Token) bool {
+	return t.Type != TypeByteOrderMark && t.Type != TypeEscapeSequence && t.Type != TypeUnterminatedShiftState
+}
+
+func (p *baseParser) newLine() {
+	p.line++
+	p.column = 1
+	p.pendingCR = false
 }
 
 func (p *baseParser) readByte() (uint8, error) {
-	return p.reader.ReadByte()
+	b, err := p.reader.ReadByte()
+	if err == nil {
+		p.offset++
+	}
+	return b, err
 }
 
 func (p *baseParser) readFull(buf []byte) (int, error) {
-	return io.ReadFull(p.reader, buf)
+	n, err := io.ReadFull(p.reader, buf)
+	p.offset += int64(n)
+	return n, err
 }
 
 func (p *baseParser) peekByte() (uint8, error) {
@@ -123,6 +333,10 @@ type utf8Parser struct {
 }
 
 func (p *utf8Parser) parse() (*Token, error) {
+	return p.track(p.parseToken)
+}
+
+func (p *utf8Parser) parseToken() (*Token, error) {
 
 	b1, err := p.readByte()
 	if err != nil {
@@ -158,6 +372,10 @@ func (p *utf8Parser) parse() (*Token, error) {
 		token := NewToken(r, TypeOk, bs)
 		if r < 0x800 {
 			token.Type = TypeRedundantEncoding
+		} else if 0xd800 <= r && r <= 0xdbff {
+			return p.readLowSurrogate(token)
+		} else if 0xdc00 <= r && r <= 0xdfff {
+			token.Type = TypeSurrogateInUTF8
 		}
 		return token, nil
 	} else if b1 <= 0xf7 {
@@ -171,14 +389,51 @@ func (p *utf8Parser) parse() (*Token, error) {
 		token := NewToken(r, TypeOk, bs)
 		if r < 0x10000 {
 			token.Type = TypeRedundantEncoding
+		} else if r > 0x10ffff {
+			token.Type = TypeOutOfRange
 		}
 		return token, nil
+	} else if b1 <= 0xfd {
+		// RFC 3629 で廃止された5バイト、6バイトの形式
+		n := 4
+		r := rune(b1 & 0x03)
+		if b1 >= 0xfc {
+			n = 5
+			r = rune(b1 & 0x01)
+		}
+		for i := 0; i < n; i++ {
+			if bs, t, err = p.readNextChar(bs); t != nil || err != nil {
+				return t, err
+			}
+			r = r<<6 | rune(bs[i+1])&0x3f
+		}
+		return NewToken(r, TypeObsoleteSequence, bs), nil
 	} else {
 		return NewToken(0, TypeInvalidByteSequence, []byte{b1}), err
 	}
 
 }
 
+// readLowSurrogate は上位サロゲートの後続に3バイトで表現された下位サロゲートが存在するとき CESU-8 として結合する
+func (p *utf8Parser) readLowSurrogate(high *Token) (*Token, error) {
+	high.Type = TypeSurrogateInUTF8
+
+	bs, err := p.peek(3)
+	if err != nil || len(bs) != 3 {
+		return high, nil
+	}
+	if bs[0] != 0xed || bs[1]&0xf0 != 0xb0 || bs[2]&0xc0 != 0x80 {
+		return high, nil
+	}
+
+	low := make([]byte, 3)
+	p.readFull(low)
+	r2 := (rune(low[1])&0x3f)<<6 | (rune(low[2]) & 0x3f) | 0xd000
+	c := (high.Rune&0x3ff)<<10 | r2&0x3ff + 0x10000
+
+	return NewToken(c, TypeCESU8, append(high.Bytes, low...)), nil
+}
+
 func (p *utf8Parser) readNextChar(bs []byte) ([]byte, *Token, error) {
 	var b byte
 	var err error
@@ -199,6 +454,10 @@ type utf16Parser struct {
 }
 
 func (p *utf16Parser) parse() (*Token, error) {
+	return p.track(p.parseToken)
+}
+
+func (p *utf16Parser) parseToken() (*Token, error) {
 
 	bytes := make([]byte, 2)
 	if n, err := p.readFull(bytes); err != nil {
@@ -247,6 +506,10 @@ type utf32Parser struct {
 }
 
 func (p *utf32Parser) parse() (*Token, error) {
+	return p.track(p.parseToken)
+}
+
+func (p *utf32Parser) parseToken() (*Token, error) {
 
 	bytes := make([]byte, 4)
 	if n, err := p.readFull(bytes); err != nil {
@@ -255,11 +518,13 @@ func (p *utf32Parser) parse() (*Token, error) {
 		}
 		return NewToken(0, TypeInvalidByteSequence, bytes[:n]), err
 	}
-	r := rune(p.ByteOrder.Uint32(bytes))
-
-	if r > 0x10ffff {
-		return NewToken(0, TypeInvalidByteSequence, bytes), nil
+	// rune に変換すると 0x80000000 以上の値が負になるため、uint32 のまま範囲を確認する
+	v := p.ByteOrder.Uint32(bytes)
+	if v > 0x10ffff {
+		return NewToken(rune(v), TypeOutOfRange, bytes), nil
+	} else if 0xd800 <= v && v <= 0xdfff {
+		return NewToken(rune(v), TypeSurrogateInUTF32, bytes), nil
 	}
 
-	return NewToken(r, TypeOk, bytes), nil
+	return NewToken(rune(v), TypeOk, bytes), nil
 }
@@ -14,6 +14,14 @@ type ParseResult struct {
 	err   error
 }
 
+func withPosition(token *Token, offset, index, line, column int64) *Token {
+	token.Offset = offset
+	token.Index = index
+	token.Line = line
+	token.Column = column
+	return token
+}
+
 type TestData struct {
 	input    []byte
 	expected []ParseResult
@@ -31,19 +39,19 @@ func TestUtf8ParserParse(t *testing.T) {
 				0xf0, 0xa9, 0xb8, 0xbd}, // 𩸽
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken('a', TypeOk, []byte{0x61}),
+					token: withPosition(NewToken('a', TypeOk, []byte{0x61}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('À', TypeOk, []byte{0xc3, 0x80}),
+					token: withPosition(NewToken('À', TypeOk, []byte{0xc3, 0x80}), 1, 1, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('あ', TypeOk, []byte{0xe3, 0x81, 0x82}),
+					token: withPosition(NewToken('あ', TypeOk, []byte{0xe3, 0x81, 0x82}), 3, 3, 1, 3),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('𩸽', TypeOk, []byte{0xf0, 0xa9, 0xb8, 0xbd}),
+					token: withPosition(NewToken('𩸽', TypeOk, []byte{0xf0, 0xa9, 0xb8, 0xbd}), 6, 6, 1, 4),
 					err:   nil,
 				},
 				ParseResult{
@@ -61,15 +69,15 @@ func TestUtf8ParserParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0xc0}),
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xc0}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0xe0, 0x80}),
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xe0, 0x80}), 1, 1, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0xf0, 0x80, 0x80}),
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xf0, 0x80, 0x80}), 3, 3, 1, 3),
 					err:   io.EOF,
 				},
 			},
@@ -84,15 +92,41 @@ func TestUtf8ParserParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken('a', TypeRedundantEncoding, []byte{0xc1, 0xa1}),
+					token: withPosition(NewToken('a', TypeRedundantEncoding, []byte{0xc1, 0xa1}), 0, 0, 1, 1),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken('a', TypeRedundantEncoding, []byte{0xe0, 0x81, 0xa1}), 2, 2, 1, 2),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken('a', TypeRedundantEncoding, []byte{0xf0, 0x80, 0x81, 0xa1}), 5, 5, 1, 3),
+					err:   nil,
+				},
+				ParseResult{
+					token: nil,
+					err:   io.EOF,
+				},
+			},
+		},
+		// サロゲート領域の符号位置が単独で表れたとき TypeSurrogateInUTF8 を返すことを確認する
+		TestData{
+			input: []byte{
+				0xed, 0xb0, 0x80,
+				0xed, 0xa0, 0x80,
+				0x61,
+			},
+			expected: []ParseResult{
+				ParseResult{
+					token: withPosition(NewToken(0xdc00, TypeSurrogateInUTF8, []byte{0xed, 0xb0, 0x80}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('a', TypeRedundantEncoding, []byte{0xe0, 0x81, 0xa1}),
+					token: withPosition(NewToken(0xd800, TypeSurrogateInUTF8, []byte{0xed, 0xa0, 0x80}), 3, 3, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('a', TypeRedundantEncoding, []byte{0xf0, 0x80, 0x81, 0xa1}),
+					token: withPosition(NewToken('a', TypeOk, []byte{0x61}), 6, 6, 1, 3),
 					err:   nil,
 				},
 				ParseResult{
@@ -101,6 +135,65 @@ func TestUtf8ParserParse(t *testing.T) {
 				},
 			},
 		},
+		// 3バイトずつで表現されたサロゲートペアのとき TypeCESU8 を返すことを確認する
+		TestData{
+			input: []byte{
+				0xed, 0xa1, 0xa7, 0xed, 0xb8, 0xbd, // 𩸽
+			},
+			expected: []ParseResult{
+				ParseResult{
+					token: withPosition(NewToken('𩸽', TypeCESU8, []byte{0xed, 0xa1, 0xa7, 0xed, 0xb8, 0xbd}), 0, 0, 1, 1),
+					err:   nil,
+				},
+				ParseResult{
+					token: nil,
+					err:   io.EOF,
+				},
+			},
+		},
+		// U+10FFFFを超える符号位置のとき TypeOutOfRange を返すことを確認する
+		TestData{
+			input: []byte{
+				0xf4, 0x90, 0x80, 0x80,
+				0xf7, 0xbf, 0xbf, 0xbf,
+			},
+			expected: []ParseResult{
+				ParseResult{
+					token: withPosition(NewToken(0x110000, TypeOutOfRange, []byte{0xf4, 0x90, 0x80, 0x80}), 0, 0, 1, 1),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken(0x1fffff, TypeOutOfRange, []byte{0xf7, 0xbf, 0xbf, 0xbf}), 4, 4, 1, 2),
+					err:   nil,
+				},
+				ParseResult{
+					token: nil,
+					err:   io.EOF,
+				},
+			},
+		},
+		// 5バイト、6バイトの形式のとき TypeObsoleteSequence を返すことを確認する
+		TestData{
+			input: []byte{
+				0xf8, 0x88, 0x80, 0x80, 0x80,
+				0xfd, 0xbf, 0xbf, 0xbf, 0xbf, 0xbf,
+				0xfc, 0x80,
+			},
+			expected: []ParseResult{
+				ParseResult{
+					token: withPosition(NewToken(0x200000, TypeObsoleteSequence, []byte{0xf8, 0x88, 0x80, 0x80, 0x80}), 0, 0, 1, 1),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken(0x7fffffff, TypeObsoleteSequence, []byte{0xfd, 0xbf, 0xbf, 0xbf, 0xbf, 0xbf}), 5, 5, 1, 2),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xfc, 0x80}), 11, 11, 1, 3),
+					err:   io.EOF,
+				},
+			},
+		},
 		// UTF-8に表れない不正なバイトの場合 TypeInvalidByteSequence を返すことを確認する
 		TestData{
 			input: []byte{
@@ -108,7 +201,7 @@ func TestUtf8ParserParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0xff}),
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xff}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
@@ -152,19 +245,19 @@ func TestUtf16ParserBeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken('a', TypeOk, []byte{0x00, 0x61}),
+					token: withPosition(NewToken('a', TypeOk, []byte{0x00, 0x61}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('À', TypeOk, []byte{0x00, 0xc0}),
+					token: withPosition(NewToken('À', TypeOk, []byte{0x00, 0xc0}), 2, 1, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('あ', TypeOk, []byte{0x30, 0x42}),
+					token: withPosition(NewToken('あ', TypeOk, []byte{0x30, 0x42}), 4, 2, 1, 3),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('𩸽', TypeOk, []byte{0xd8, 0x67, 0xde, 0x3d}),
+					token: withPosition(NewToken('𩸽', TypeOk, []byte{0xd8, 0x67, 0xde, 0x3d}), 6, 3, 1, 4),
 					err:   nil,
 				},
 				ParseResult{
@@ -180,7 +273,7 @@ func TestUtf16ParserBeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0x61}),
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x61}), 0, 0, 1, 1),
 					err:   io.ErrUnexpectedEOF,
 				},
 			},
@@ -192,7 +285,7 @@ func TestUtf16ParserBeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeIncompleteSurrogatePair, []byte{0xd8, 0x00}),
+					token: withPosition(NewToken(0, TypeIncompleteSurrogatePair, []byte{0xd8, 0x00}), 0, 0, 1, 1),
 					err:   io.EOF,
 				},
 			},
@@ -205,11 +298,11 @@ func TestUtf16ParserBeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeIncompleteSurrogatePair, []byte{0xd8, 0x00}),
+					token: withPosition(NewToken(0, TypeIncompleteSurrogatePair, []byte{0xd8, 0x00}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('a', TypeOk, []byte{0x00, 0x61}),
+					token: withPosition(NewToken('a', TypeOk, []byte{0x00, 0x61}), 2, 1, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
@@ -225,7 +318,7 @@ func TestUtf16ParserBeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeIncompleteSurrogatePair, []byte{0xdc, 0x00}),
+					token: withPosition(NewToken(0, TypeIncompleteSurrogatePair, []byte{0xdc, 0x00}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
@@ -269,19 +362,19 @@ func TestUtf16ParserLeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken('a', TypeOk, []byte{0x61, 0x00}),
+					token: withPosition(NewToken('a', TypeOk, []byte{0x61, 0x00}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('À', TypeOk, []byte{0xc0, 0x00}),
+					token: withPosition(NewToken('À', TypeOk, []byte{0xc0, 0x00}), 2, 1, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('あ', TypeOk, []byte{0x42, 0x30}),
+					token: withPosition(NewToken('あ', TypeOk, []byte{0x42, 0x30}), 4, 2, 1, 3),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('𩸽', TypeOk, []byte{0x67, 0xd8, 0x3d, 0xde}),
+					token: withPosition(NewToken('𩸽', TypeOk, []byte{0x67, 0xd8, 0x3d, 0xde}), 6, 3, 1, 4),
 					err:   nil,
 				},
 				ParseResult{
@@ -297,7 +390,7 @@ func TestUtf16ParserLeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0x61}),
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x61}), 0, 0, 1, 1),
 					err:   io.ErrUnexpectedEOF,
 				},
 			},
@@ -309,7 +402,7 @@ func TestUtf16ParserLeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeIncompleteSurrogatePair, []byte{0x00, 0xd8}),
+					token: withPosition(NewToken(0, TypeIncompleteSurrogatePair, []byte{0x00, 0xd8}), 0, 0, 1, 1),
 					err:   io.EOF,
 				},
 			},
@@ -322,11 +415,11 @@ func TestUtf16ParserLeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeIncompleteSurrogatePair, []byte{0x00, 0xd8}),
+					token: withPosition(NewToken(0, TypeIncompleteSurrogatePair, []byte{0x00, 0xd8}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('a', TypeOk, []byte{0x61, 0x00}),
+					token: withPosition(NewToken('a', TypeOk, []byte{0x61, 0x00}), 2, 1, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
@@ -342,7 +435,7 @@ func TestUtf16ParserLeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeIncompleteSurrogatePair, []byte{0x00, 0xdc}),
+					token: withPosition(NewToken(0, TypeIncompleteSurrogatePair, []byte{0x00, 0xdc}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
@@ -386,19 +479,19 @@ func TestUtf32ParserBeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken('a', TypeOk, []byte{0x00, 0x00, 0x00, 0x61}),
+					token: withPosition(NewToken('a', TypeOk, []byte{0x00, 0x00, 0x00, 0x61}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('À', TypeOk, []byte{0x00, 0x00, 0x00, 0xc0}),
+					token: withPosition(NewToken('À', TypeOk, []byte{0x00, 0x00, 0x00, 0xc0}), 4, 1, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('あ', TypeOk, []byte{0x00, 0x00, 0x30, 0x42}),
+					token: withPosition(NewToken('あ', TypeOk, []byte{0x00, 0x00, 0x30, 0x42}), 8, 2, 1, 3),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('𩸽', TypeOk, []byte{0x00, 0x02, 0x9e, 0x3d}),
+					token: withPosition(NewToken('𩸽', TypeOk, []byte{0x00, 0x02, 0x9e, 0x3d}), 12, 3, 1, 4),
 					err:   nil,
 				},
 				ParseResult{
@@ -414,19 +507,42 @@ func TestUtf32ParserBeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0x00, 0x61}),
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x00, 0x61}), 0, 0, 1, 1),
 					err:   io.ErrUnexpectedEOF,
 				},
 			},
 		},
-		// 面11以降が不正と判定されることを確認する
+		// 面11以降が TypeOutOfRange と判定されることを確認する
+		// 0x80000000 以上の値も負の rune として見逃さないことを確認する
 		TestData{
 			input: []byte{
 				0x00, 0x11, 0x00, 0x00,
+				0xff, 0xff, 0xff, 0xff,
+			},
+			expected: []ParseResult{
+				ParseResult{
+					token: withPosition(NewToken(0x110000, TypeOutOfRange, []byte{0x00, 0x11, 0x00, 0x00}), 0, 0, 1, 1),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken(-1, TypeOutOfRange, []byte{0xff, 0xff, 0xff, 0xff}), 4, 1, 1, 2),
+					err:   nil,
+				},
+			},
+		},
+		// サロゲートの符号位置が TypeSurrogateInUTF32 と判定されることを確認する
+		TestData{
+			input: []byte{
+				0x00, 0x00, 0xd8, 0x00,
+				0x00, 0x00, 0xdf, 0xff,
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0x00, 0x11, 0x00, 0x00}),
+					token: withPosition(NewToken(0xd800, TypeSurrogateInUTF32, []byte{0x00, 0x00, 0xd8, 0x00}), 0, 0, 1, 1),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken(0xdfff, TypeSurrogateInUTF32, []byte{0x00, 0x00, 0xdf, 0xff}), 4, 1, 1, 2),
 					err:   nil,
 				},
 			},
@@ -466,19 +582,19 @@ func TestUtf32ParserLeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken('a', TypeOk, []byte{0x61, 0x00, 0x00, 0x00}),
+					token: withPosition(NewToken('a', TypeOk, []byte{0x61, 0x00, 0x00, 0x00}), 0, 0, 1, 1),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('À', TypeOk, []byte{0xc0, 0x00, 0x00, 0x00}),
+					token: withPosition(NewToken('À', TypeOk, []byte{0xc0, 0x00, 0x00, 0x00}), 4, 1, 1, 2),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('あ', TypeOk, []byte{0x42, 0x30, 0x00, 0x00}),
+					token: withPosition(NewToken('あ', TypeOk, []byte{0x42, 0x30, 0x00, 0x00}), 8, 2, 1, 3),
 					err:   nil,
 				},
 				ParseResult{
-					token: NewToken('𩸽', TypeOk, []byte{0x3d, 0x9e, 0x02, 0x00}),
+					token: withPosition(NewToken('𩸽', TypeOk, []byte{0x3d, 0x9e, 0x02, 0x00}), 12, 3, 1, 4),
 					err:   nil,
 				},
 				ParseResult{
@@ -494,19 +610,42 @@ func TestUtf32ParserLeParse(t *testing.T) {
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0x00, 0x61}),
+					token: withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0x00, 0x61}), 0, 0, 1, 1),
 					err:   io.ErrUnexpectedEOF,
 				},
 			},
 		},
-		// 面11以降が不正と判定されることを確認する
+		// 面11以降が TypeOutOfRange と判定されることを確認する
+		// 0x80000000 以上の値も負の rune として見逃さないことを確認する
 		TestData{
 			input: []byte{
 				0x00, 0x00, 0x11, 0x00,
+				0xff, 0xff, 0xff, 0xff,
 			},
 			expected: []ParseResult{
 				ParseResult{
-					token: NewToken(0, TypeInvalidByteSequence, []byte{0x00, 0x00, 0x11, 0x00}),
+					token: withPosition(NewToken(0x110000, TypeOutOfRange, []byte{0x00, 0x00, 0x11, 0x00}), 0, 0, 1, 1),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken(-1, TypeOutOfRange, []byte{0xff, 0xff, 0xff, 0xff}), 4, 1, 1, 2),
+					err:   nil,
+				},
+			},
+		},
+		// サロゲートの符号位置が TypeSurrogateInUTF32 と判定されることを確認する
+		TestData{
+			input: []byte{
+				0x00, 0xd8, 0x00, 0x00,
+				0xff, 0xdf, 0x00, 0x00,
+			},
+			expected: []ParseResult{
+				ParseResult{
+					token: withPosition(NewToken(0xd800, TypeSurrogateInUTF32, []byte{0x00, 0xd8, 0x00, 0x00}), 0, 0, 1, 1),
+					err:   nil,
+				},
+				ParseResult{
+					token: withPosition(NewToken(0xdfff, TypeSurrogateInUTF32, []byte{0xff, 0xdf, 0x00, 0x00}), 4, 1, 1, 2),
 					err:   nil,
 				},
 			},
@@ -532,3 +671,52 @@ func TestUtf32ParserLeParse(t *testing.T) {
 	}
 
 }
+
+func TestParserLineColumn(t *testing.T) {
+
+	// LF, CR, CRLF, NEL, LS, PS が混在するとき行番号と桁番号が正しく数えられることを確認する
+	text := "a\nb\rc\r\nd\u0085e\u2028f\u2029g"
+	expected := [][2]int64{
+		{1, 1}, {1, 2}, // a LF
+		{2, 1}, {2, 2}, // b CR
+		{3, 1}, {3, 2}, {3, 3}, // c CR LF
+		{4, 1}, {4, 2}, // d NEL
+		{5, 1}, {5, 2}, // e LS
+		{6, 1}, {6, 2}, // f PS
+		{7, 1}, // g
+	}
+
+	runes := []rune(text)
+	utf8Input := []byte(text)
+	utf16Input := []byte{}
+	utf32Input := []byte{}
+	for _, r := range runes {
+		utf16Input = append(utf16Input, byte(r>>8), byte(r))
+		utf32Input = append(utf32Input, 0, 0, byte(r>>8), byte(r))
+	}
+
+	cases := []struct {
+		name   string
+		parser Parser
+	}{
+		{"UTF-8", NewParser(bufio.NewReader(bytes.NewReader(utf8Input)), 8, nil)},
+		{"UTF-16BE", NewParser(bufio.NewReader(bytes.NewReader(utf16Input)), 16, binary.BigEndian)},
+		{"UTF-32BE", NewParser(bufio.NewReader(bytes.NewReader(utf32Input)), 32, binary.BigEndian)},
+	}
+
+	for _, c := range cases {
+		for j, e := range expected {
+			actual, err := c.parser.parse()
+			if err != nil {
+				t.Fatalf("[%s,%d] unexpected error: %v", c.name, j, err)
+			}
+			if actual.Rune != runes[j] || actual.Line != e[0] || actual.Column != e[1] {
+				t.Errorf("[%s,%d] expected: %U %d:%d, actual %U %s", c.name, j, runes[j], e[0], e[1], actual.Rune, actual.Position())
+			}
+		}
+		if actual, err := c.parser.parse(); actual != nil || err != io.EOF {
+			t.Errorf("[%s] expected: EOF, actual %#v, %#v", c.name, actual, err)
+		}
+	}
+
+}
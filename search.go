@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/runenames"
+)
+
+// runSearch は search サブコマンドを実行し、終了ステータスを返す
+func runSearch(args []string) int {
+	var useRegexp bool
+
+	flags := flag.NewFlagSet("search", flag.ExitOnError)
+	flags.BoolVar(&useRegexp, "r", false, "treat pattern as a regular expression")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s search [options] pattern\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Searches character names and name aliases case-insensitively.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	match, err := newNameMatcher(flags.Arg(0), useRegexp)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	found := false
+	for _, r := range searchNames(match) {
+		token := NewToken(r, TypeOk, encodeRune(r, 8, nil))
+		fmt.Println(token.Columns())
+		found = true
+	}
+	if !found {
+		return 1
+	}
+	return 0
+}
+
+// newNameMatcher は大文字小文字を区別せずに部分一致、または正規表現で名前を照合する関数を返す
+func newNameMatcher(pattern string, useRegexp bool) (func(string) bool, error) {
+	if useRegexp {
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString, nil
+	}
+
+	pattern = strings.ToUpper(pattern)
+	return func(name string) bool {
+		return strings.Contains(strings.ToUpper(name), pattern)
+	}, nil
+}
+
+// searchNames は名前または別名が match に一致する符号位置を返す
+func searchNames(match func(string) bool) []rune {
+	runes := []rune{}
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if utf8.ValidRune(r) && matchNames(r, match) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+func matchNames(r rune, match func(string) bool) bool {
+	if name := runenames.Name(r); name != "" && match(name) {
+		return true
+	}
+	if alias, ok := controlCodeAliases[r]; ok && match(alias) {
+		return true
+	}
+	for _, a := range nameAliases[r] {
+		if match(a.alias) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchNames(t *testing.T) {
+
+	cases := []struct {
+		pattern   string
+		useRegexp bool
+		expected  []rune
+	}{
+		// 大文字小文字を区別せずに部分一致で検索できることを確認する
+		{"zero width", false, []rune{0x200b, 0x200c, 0x200d, 0xfeff}},
+		// 制御文字の別名を名前とあわせて検索できることを確認する
+		{"CARRIAGE RETURN", false, []rune{0x0d, 0x240d}},
+		// 正規表現で検索できることを確認する
+		{"^latin small letter [a-c]$", true, []rune{'a', 'b', 'c'}},
+		{"^no such character$", true, []rune{}},
+	}
+
+	for i, c := range cases {
+		match, err := newNameMatcher(c.pattern, c.useRegexp)
+		if err != nil {
+			t.Fatalf("[%d] unexpected error: %v", i, err)
+		}
+		if actual := searchNames(match); !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("[%d] expected: %#v, actual %#v", i, c.expected, actual)
+		}
+	}
+
+	// 不正な正規表現のときエラーを返すことを確認する
+	if _, err := newNameMatcher("(", true); err == nil {
+		t.Errorf("expected: error, actual nil")
+	}
+
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// summary は出力した Token の統計を集計する
+type summary struct {
+	bytes      int64
+	codePoints int64
+	tokens     int64
+	invalid    int64
+	types      map[int]int64
+	blocks     map[string]int64
+	scripts    map[string]int64
+}
+
+func newSummary() *summary {
+	return &summary{
+		types:   map[int]int64{},
+		blocks:  map[string]int64{},
+		scripts: map[string]int64{},
+	}
+}
+
+func (s *summary) add(t *Token) {
+	s.tokens++
+	s.bytes += int64(len(t.Bytes))
+	s.types[t.Type]++
+	if !t.IsValid() {
+		s.invalid++
+	}
+	if t.IsCharacter() {
+		s.codePoints++
+		s.blocks[blockName(t.Rune)]++
+		s.scripts[scriptName(t.Rune)]++
+	}
+}
+
+func (s *summary) String() string {
+	lines := []string{
+		"summary:",
+		fmt.Sprintf("  bytes: %d", s.bytes),
+		fmt.Sprintf("  code points: %d", s.codePoints),
+		fmt.Sprintf("  tokens: %d", s.tokens),
+		fmt.Sprintf("  invalid tokens: %d", s.invalid),
+		"  types:",
+	}
+
+	types := []int{}
+	for t := range s.types {
+		types = append(types, t)
+	}
+	sort.Ints(types)
+	for _, t := range types {
+		lines = append(lines, fmt.Sprintf("    %s: %d", typeNames[t], s.types[t]))
+	}
+
+	// ブロックは符号位置の順に並べる
+	lines = append(lines, "  blocks:")
+	for _, b := range unicodeBlocks {
+		if n, ok := s.blocks[b.name]; ok {
+			lines = append(lines, fmt.Sprintf("    %s: %d", b.name, n))
+		}
+	}
+	if n, ok := s.blocks["No_Block"]; ok {
+		lines = append(lines, fmt.Sprintf("    No_Block: %d", n))
+	}
+
+	lines = append(lines, "  scripts:")
+	scripts := []string{}
+	for name := range s.scripts {
+		scripts = append(scripts, name)
+	}
+	sort.Strings(scripts)
+	for _, name := range scripts {
+		lines = append(lines, fmt.Sprintf("    %s: %d", name, s.scripts[name]))
+	}
+
+	return strings.Join(lines, "\n")
+}
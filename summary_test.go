@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestSummaryAdd(t *testing.T) {
+
+	s := newSummary()
+	for _, token := range []*Token{
+		NewToken(0xfeff, TypeByteOrderMark, []byte{0xef, 0xbb, 0xbf}),
+		NewToken('a', TypeOk, []byte{0x61}),
+		NewToken('あ', TypeOk, []byte{0xe3, 0x81, 0x82}),
+		NewToken(0, TypeInvalidByteSequence, []byte{0xff}),
+		NewToken('a', TypeRedundantEncoding, []byte{0xc1, 0xa1}),
+	} {
+		s.add(token)
+	}
+
+	if s.bytes != 10 || s.codePoints != 4 || s.tokens != 5 {
+		t.Errorf("expected: 10 bytes, 4 code points, 5 tokens, actual %d, %d, %d", s.bytes, s.codePoints, s.tokens)
+	}
+	// BOM は正しい入力として扱うことを確認する
+	if s.invalid != 2 {
+		t.Errorf("expected: 2 invalid tokens, actual %d", s.invalid)
+	}
+	if s.types[TypeOk] != 2 || s.types[TypeInvalidByteSequence] != 1 {
+		t.Errorf("unexpected type counts: %v", s.types)
+	}
+	if s.blocks["Basic Latin"] != 2 || s.blocks["Hiragana"] != 1 {
+		t.Errorf("unexpected block counts: %v", s.blocks)
+	}
+	if s.scripts["Latin"] != 2 || s.scripts["Hiragana"] != 1 || s.scripts["Common"] != 1 {
+		t.Errorf("unexpected script counts: %v", s.scripts)
+	}
+
+}
+
+func TestBlockName(t *testing.T) {
+
+	cases := []struct {
+		input    rune
+		expected string
+	}{
+		{0x0000, "Basic Latin"},
+		{0x007f, "Basic Latin"},
+		{0x0080, "Latin-1 Supplement"},
+		{0x3042, "Hiragana"},
+		{0x29e3d, "CJK Unified Ideographs Extension B"},
+		{0x10ffff, "Supplementary Private Use Area-B"},
+		{0x0860, "Syriac Supplement"},
+		{0x2fe0, "No_Block"},
+	}
+
+	for i, c := range cases {
+		if actual := blockName(c.input); actual != c.expected {
+			t.Errorf("[%d] expected: %s, actual %s", i, c.expected, actual)
+		}
+	}
+
+}
@@ -35,6 +35,8 @@ var controlCodeSymbols = map[rune]string{
 	0x1F: "US",
 	0x7F: "DEL",
 
+	0x80: "PAD",
+	0x81: "HOP",
 	0x82: "BPH",
 	0x83: "NBH",
 	0x84: "IND",
@@ -59,7 +61,7 @@ var controlCodeSymbols = map[rune]string{
 	0x96: "SPA",
 	0x97: "EPA",
 	0x98: "SOS",
-
+	0x99: "SGC",
 	0x9a: "SCI",
 	0x9b: "CSI",
 	0x9c: "ST",
@@ -68,66 +70,94 @@ var controlCodeSymbols = map[rune]string{
 	0x9f: "APC",
 }
 
-var controlCodeAliases = map[rune]string{
-	0x00: "NULL",
-	0x01: "START OF HEADING",
-	0x02: "START OF TEXT",
-	0x03: "END OF TEXT",
-	0x04: "END OF TRANSMISSION",
-	0x05: "ENQUIRY",
-	0x06: "ACKNOWLEDGE",
-	0x07: "BELL",
-	0x08: "BACKSPACE",
-	0x09: "CHARACTER TABULATION",
-	0x0A: "LINE FEED",
-	0x0B: "LINE TABULATION",
-	0x0C: "FORM FEED",
-	0x0D: "CARRIAGE RETURN",
-	0x0E: "SHIFT OUT",
-	0x0F: "SHIFT IN",
-	0x10: "DATA LINK ESCAPE",
-	0x11: "DEVICE CONTROL ONE",
-	0x12: "DEVICE CONTROL TWO",
-	0x13: "DEVICE CONTROL THREE",
-	0x14: "DEVICE CONTROL FOUR",
-	0x15: "NEGATIVE ACKNOWLEDGE",
-	0x16: "SYNCHRONOUS IDLE",
-	0x17: "END OF TRANSMISSION BLOCK",
-	0x18: "CANCEL",
-	0x19: "END OF MEDIUM",
-	0x1A: "SUBSTITUTE",
-	0x1B: "ESCAPE",
-	0x1C: "INFORMATION SEPARATOR FOUR",
-	0x1D: "INFORMATION SEPARATOR THREE",
-	0x1E: "INFORMATION SEPARATOR TWO",
-	0x1F: "INFORMATION SEPARATOR ONE",
-	0x7F: "DELETE",
-	0x82: "BREAK PERMITTED HERE",
-	0x83: "NO BREAK HERE",
-	0x85: "NEXT LINE",
-	0x86: "START OF SELECTED AREA",
-	0x87: "END OF SELECTED AREA",
-	0x88: "CHARACTER TABULATION SET",
-	0x89: "CHARACTER TABULATION WITH JUSTIFICATION",
-	0x8a: "LINE TABULATION SET",
-	0x8b: "PARTIAL LINE FORWARD",
-	0x8c: "PARTIAL LINE BACKWARD",
-	0x8d: "REVERSE LINE FEED",
-	0x8e: "SINGLE SHIFT TWO",
-	0x8f: "SINGLE SHIFT THREE",
-	0x90: "DEVICE CONTROL STRING",
-	0x91: "PRIVATE USE ONE",
-	0x92: "PRIVATE USE TWO",
-	0x93: "SET TRANSMIT STATE",
-	0x94: "CANCEL CHARACTER",
-	0x95: "MESSAGE WAITING",
-	0x96: "START OF GUARDED AREA",
-	0x97: "END OF GUARDED AREA",
-	0x98: "START OF STRING",
-	0x9a: "SINGLE CHARACTER INTRODUCER",
-	0x9b: "CONTROL SEQUENCE INTRODUCER",
-	0x9c: "STRING TERMINATOR",
-	0x9d: "OPERATING SYSTEM COMMAND",
-	0x9e: "PRIVACY MESSAGE",
-	0x9f: "APPLICATION PROGRAM COMMAND",
+// controlCodeAliases は制御文字の別名として、NameAliases のうち種類が control の最初の別名を持つ
+// figment は実際の規格で定義されていない名前のため含めない
+var controlCodeAliases = func() map[rune]string {
+	aliases := map[rune]string{}
+	for r, as := range nameAliases {
+		for _, a := range as {
+			if a.kind == "control" {
+				aliases[r] = a.alias
+				break
+			}
+		}
+	}
+	return aliases
+}()
+
+// invisibleSymbols は空白文字(White_Space)と既定で無視される文字(Default_Ignorable_Code_Point)を表す記号
+// 異体字セレクタとタグ文字は invisibleSymbol で符号位置から求める
+var invisibleSymbols = map[rune]string{
+	0x0020:  "SP",
+	0x00a0:  "NBSP",
+	0x00ad:  "SHY",
+	0x034f:  "CGJ",
+	0x061c:  "ALM",
+	0x115f:  "HCF",
+	0x1160:  "HJF",
+	0x1680:  "OSM",
+	0x17b4:  "KIVAQ",
+	0x17b5:  "KIVAA",
+	0x180b:  "FVS1",
+	0x180c:  "FVS2",
+	0x180d:  "FVS3",
+	0x180e:  "MVS",
+	0x180f:  "FVS4",
+	0x2000:  "NQSP",
+	0x2001:  "MQSP",
+	0x2002:  "ENSP",
+	0x2003:  "EMSP",
+	0x2004:  "3/MSP",
+	0x2005:  "4/MSP",
+	0x2006:  "6/MSP",
+	0x2007:  "FSP",
+	0x2008:  "PSP",
+	0x2009:  "THSP",
+	0x200a:  "HSP",
+	0x200b:  "ZWSP",
+	0x200c:  "ZWNJ",
+	0x200d:  "ZWJ",
+	0x200e:  "LRM",
+	0x200f:  "RLM",
+	0x2028:  "LS",
+	0x2029:  "PS",
+	0x202a:  "LRE",
+	0x202b:  "RLE",
+	0x202c:  "PDF",
+	0x202d:  "LRO",
+	0x202e:  "RLO",
+	0x202f:  "NNBSP",
+	0x205f:  "MMSP",
+	0x2060:  "WJ",
+	0x2061:  "FA",
+	0x2062:  "IT",
+	0x2063:  "ISEP",
+	0x2064:  "IP",
+	0x2066:  "LRI",
+	0x2067:  "RLI",
+	0x2068:  "FSI",
+	0x2069:  "PDI",
+	0x206a:  "ISS",
+	0x206b:  "ASS",
+	0x206c:  "IAFS",
+	0x206d:  "AAFS",
+	0x206e:  "NADS",
+	0x206f:  "NODS",
+	0x3000:  "IDSP",
+	0x3164:  "HF",
+	0xfeff:  "ZWNBSP",
+	0xffa0:  "HWHF",
+	0x1bca0: "SFLO",
+	0x1bca1: "SFCO",
+	0x1bca2: "SFDS",
+	0x1bca3: "SFUS",
+	0x1d173: "BBEAM",
+	0x1d174: "EBEAM",
+	0x1d175: "BTIE",
+	0x1d176: "ETIE",
+	0x1d177: "BSLUR",
+	0x1d178: "ESLUR",
+	0x1d179: "BPHR",
+	0x1d17a: "EPHR",
+	0xe0001: "LTAG",
 }
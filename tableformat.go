@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// 結合文字の前に置く基底文字(DOTTED CIRCLE)
+const combiningPlaceholder = "◌"
+
+// displayWidth は端末に表示したときの s の幅を East Asian Width に従って返す
+// 結合文字と書式制御文字は幅 0、Wide と Fullwidth は幅 2、それ以外(Ambiguous を含む)は幅 1 とする
+func displayWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case width.LookupRune(r).Kind() == width.EastAsianWide || width.LookupRune(r).Kind() == width.EastAsianFullwidth:
+			w += 2
+		default:
+			w++
+		}
+	}
+	return w
+}
+
+// tableSymbol は Symbol を返す。結合文字はタブや前の列に重ならないよう基底文字を補う
+func tableSymbol(t *Token) string {
+	symbol := t.Symbol()
+	if t.IsCharacter() && unicode.In(t.Rune, unicode.Mn, unicode.Me, unicode.Mc) && symbol == string(t.Rune) {
+		return combiningPlaceholder + symbol
+	}
+	return symbol
+}
+
+// 列の幅を決めるためにまとめて出力する行数
+const tableBatchRows = 256
+
+// tableFormatter は表示幅を揃えた表として Token を出力する
+// 列の幅は tableBatchRows 行ごとに決め、前の行とずれないよう狭くはしない
+type tableFormatter struct {
+	w      io.Writer
+	opts   options
+	rows   [][]string
+	colors []string
+	widths []int
+}
+
+func (f *tableFormatter) begin() error {
+	return nil
+}
+
+func (f *tableFormatter) write(path string, token *Token) error {
+	cells := []string{}
+	if f.opts.position {
+		cells = append(cells, token.Position())
+	}
+	cells = append(cells, fmt.Sprintf("%08x", token.Offset))
+
+	columns := strings.Split(token.Columns(), "\t")
+	columns[0] = tableSymbol(token)
+	cells = append(cells, columns...)
+	if properties := propertyColumns(token, f.opts); properties != "" {
+		cells = append(cells, strings.Split(properties, "\t")[1:]...)
+	}
+
+	color := ""
+	if f.opts.color {
+		color = tokenColor(token)
+	}
+	f.rows = append(f.rows, cells)
+	f.colors = append(f.colors, color)
+	if len(f.rows) == tableBatchRows {
+		return f.flush()
+	}
+	return nil
+}
+
+// flush はまとめていた行の幅を揃えて出力する
+func (f *tableFormatter) flush() error {
+	for _, cells := range f.rows {
+		for i, cell := range cells {
+			if i >= len(f.widths) {
+				f.widths = append(f.widths, 0)
+			}
+			if w := displayWidth(cell); w > f.widths[i] {
+				f.widths[i] = w
+			}
+		}
+	}
+
+	for i, cells := range f.rows {
+		var b strings.Builder
+		for j, cell := range cells {
+			b.WriteString(cell)
+			if j < len(cells)-1 {
+				b.WriteString(strings.Repeat(" ", f.widths[j]-displayWidth(cell)+2))
+			}
+		}
+		if _, err := fmt.Fprintln(f.w, colorize(strings.TrimRight(b.String(), " "), f.colors[i])); err != nil {
+			return err
+		}
+	}
+	f.rows = f.rows[:0]
+	f.colors = f.colors[:0]
+	return nil
+}
+
+// note はまとめていた行を出力してから msg を出力する
+func (f *tableFormatter) note(msg string) error {
+	if err := f.flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(f.w, msg)
+	return err
+}
+
+func (f *tableFormatter) end() error {
+	return f.flush()
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+
+	cases := []struct {
+		input    string
+		expected int
+	}{
+		{"a", 1},
+		{"\u3042", 2},
+		{"\uff41", 2},
+		{"\uff71", 1},
+		// 結合文字と書式制御文字は幅を持たないことを確認する
+		{"e\u0301", 1},
+		{"\u200b", 0},
+		// Ambiguous は幅 1 として扱うことを確認する
+		{"\u25cc", 1},
+	}
+
+	for i, c := range cases {
+		if actual := displayWidth(c.input); actual != c.expected {
+			t.Errorf("[%d] expected: %d, actual %d", i, c.expected, actual)
+		}
+	}
+
+}
+
+func TestTableFormatter(t *testing.T) {
+
+	tokens := []*Token{
+		NewToken('a', TypeOk, []byte{0x61}),
+		withPosition(NewToken(0x3042, TypeOk, []byte{0xe3, 0x81, 0x82}), 1, 1, 1, 2),
+		withPosition(NewToken(0x0301, TypeOk, []byte{0xcc, 0x81}), 4, 4, 1, 3),
+		withPosition(NewToken(0, TypeInvalidByteSequence, []byte{0xff}), 6, 6, 1, 4),
+	}
+	expected := "00000000  a   U+0061  61        LATIN SMALL LETTER A\n" +
+		"00000001  \u3042  U+3042  e3 81 82  HIRAGANA LETTER A\n" +
+		"00000004  \u25cc\u0301   U+0301  cc 81     COMBINING ACUTE ACCENT\n" +
+		"note\n" +
+		"00000006              ff\n"
+
+	buf := bytes.Buffer{}
+	f := newFormatter("table", &buf, &bytes.Buffer{}, options{})
+	f.begin()
+	for _, token := range tokens[:3] {
+		f.write("-", token)
+	}
+	f.note("note")
+	f.write("-", tokens[3])
+	f.end()
+
+	// 全角文字と結合文字の表示幅を考慮して列が揃うことを確認する
+	if buf.String() != expected {
+		t.Errorf("expected: %q, actual %q", expected, buf.String())
+	}
+
+}
+
+func TestTableFormatterBatch(t *testing.T) {
+
+	buf := bytes.Buffer{}
+	f := newFormatter("table", &buf, &bytes.Buffer{}, options{})
+	f.begin()
+	for i := 0; i < tableBatchRows; i++ {
+		f.write("-", NewToken('a', TypeOk, []byte{0x61}))
+	}
+
+	// end を待たずに tableBatchRows 行ごとに出力されることを確認する
+	if lines := bytes.Count(buf.Bytes(), []byte("\n")); lines != tableBatchRows {
+		t.Errorf("expected: %d lines, actual %d", tableBatchRows, lines)
+	}
+
+	// 後の行で広がった列の幅は狭くならないことを確認する
+	f.write("-", NewToken(0x3042, TypeOk, []byte{0xe3, 0x81, 0x82}))
+	f.end()
+	f.write("-", NewToken('a', TypeOk, []byte{0x61}))
+	f.end()
+	expected := "00000000  a   U+0061  61        LATIN SMALL LETTER A\n"
+	if actual := buf.String()[buf.Len()-len(expected):]; actual != expected {
+		t.Errorf("expected: %q, actual %q", expected, actual)
+	}
+
+}
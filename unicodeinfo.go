@@ -0,0 +1,45 @@
+package main
+
+import (
+	"sort"
+	"unicode"
+)
+
+var generalCategories = []string{
+	"Lu", "Ll", "Lt", "Lm", "Lo",
+	"Mn", "Mc", "Me",
+	"Nd", "Nl", "No",
+	"Pc", "Pd", "Ps", "Pe", "Pi", "Pf", "Po",
+	"Sm", "Sc", "Sk", "So",
+	"Zs", "Zl", "Zp",
+	"Cc", "Cf", "Cs", "Co",
+}
+
+// generalCategory は r の一般カテゴリの略称を返す。どのカテゴリにも属さないときは Cn(未割り当て)を返す
+func generalCategory(r rune) string {
+	for _, c := range generalCategories {
+		if unicode.Is(unicode.Categories[c], r) {
+			return c
+		}
+	}
+	return "Cn"
+}
+
+var scriptNames = func() []string {
+	names := []string{}
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// scriptName は r の用字の名前を返す。どの用字にも属さないときは Unknown を返す
+func scriptName(r rune) string {
+	for _, name := range scriptNames {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	return "Unknown"
+}